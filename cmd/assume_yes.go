@@ -0,0 +1,49 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+)
+
+// assumeYesFlag answers every confirmation prompt automatically, across all commands that gate a
+// mutation behind one.
+var assumeYesFlag bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&assumeYesFlag, "assume-yes", false,
+		"Answer yes to every confirmation prompt; implied when the CI environment variable is truthy")
+}
+
+// assumeYes reports whether confirmation prompts should be answered automatically: either
+// --assume-yes was passed, or the process runs under CI (CI=true), where blocking on a prompt
+// would hang the job.
+func assumeYes() bool {
+	if assumeYesFlag {
+		return true
+	}
+	ci, _ := strconv.ParseBool(os.Getenv("CI"))
+	return ci
+}
+
+// applyAssumeYes installs the non-interactive mode before any command can prompt.
+func applyAssumeYes() {
+	if assumeYes() {
+		pkg.SetAssumeYes(true)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/spf13/cobra"
+)
+
+func newCleanCmd() *cobra.Command {
+	var migrationFilePath string
+
+	cmd := &cobra.Command{
+		Use:   "clean",
+		Short: "Remove generated temp artifacts of a migration",
+		Long: `Remove generated temp artifacts that accumulate as a migration progresses.
+
+Deletes leftover partial saves (migration.json.tmp-*) and the migration.json.bak backup next to
+the migration file, along with orphaned import-stub-*.json and import-resolved-*.json files in
+the system temp directory. The migration file itself is never touched.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := migration.LoadMigration(migrationFilePath); err != nil {
+				return fmt.Errorf("failed to load migration file: %w", err)
+			}
+
+			removed, err := migration.CleanArtifacts(migrationFilePath)
+			for _, path := range removed {
+				fmt.Printf("Removed %s\n", path)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to clean artifacts: %w", err)
+			}
+			if len(removed) == 0 {
+				fmt.Println("Nothing to clean.")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&migrationFilePath, "migration", "m", "", "Path to the migration.json file")
+	cmd.MarkFlagRequired("migration")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newCleanCmd())
+}
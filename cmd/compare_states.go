@@ -0,0 +1,105 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+	"github.com/spf13/cobra"
+)
+
+func newCompareStatesCmd() *cobra.Command {
+	var tf string
+	var pulumiSources string
+	var pulumiStack string
+
+	cmd := &cobra.Command{
+		Use:   "compare-states",
+		Short: "Compare a Terraform state against an imported Pulumi stack",
+		Long: `Compare a Terraform state against an imported Pulumi stack to verify migration fidelity.
+
+Loads both states and compares, per resource, the key attributes: the cloud provider ID and the
+scalar attributes both states know about. Mismatches are reported with attribute-level detail, and
+Terraform resources with no counterpart in the stack are reported as missing.
+
+This is a correctness check on the recorded states themselves, distinct from 'diff' which uses
+'pulumi preview' to classify pending operations.
+
+Example:
+
+  pulumi-terraform-migrate compare-states \
+    --tf path/to/terraform-sources \
+    --pulumi-sources path/to/pulumi-project \
+    --pulumi-stack dev
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			loadOpts, err := tofu.StateInputOptions(tf)
+			if err != nil {
+				return err
+			}
+			tfState, err := tofu.LoadTerraformState(cmd.Context(), loadOpts)
+			if err != nil {
+				return fmt.Errorf("failed to load Terraform state: %w", err)
+			}
+
+			comparisons, err := pkg.CompareStates(cmd.Context(), tfState, pulumiSources, pulumiStack)
+			if err != nil {
+				return fmt.Errorf("failed to compare states: %w", err)
+			}
+
+			matched, missing, mismatched := 0, 0, 0
+			for _, comparison := range comparisons {
+				switch comparison.Status {
+				case pkg.CompareStatusMatched:
+					matched++
+					continue
+				case pkg.CompareStatusMissing:
+					missing++
+					fmt.Printf("%-10s %s\n", comparison.Status, comparison.TFAddr)
+				case pkg.CompareStatusMismatched:
+					mismatched++
+					fmt.Printf("%-10s %s (%s)\n", comparison.Status, comparison.TFAddr, comparison.URN)
+					for _, mismatch := range comparison.Mismatches {
+						fmt.Printf("  %s: terraform has %v, pulumi has %v\n",
+							mismatch.Attribute, mismatch.TFValue, mismatch.PulumiValue)
+					}
+				}
+			}
+			fmt.Printf("%d resources compared: %d matched, %d mismatched, %d missing\n",
+				len(comparisons), matched, mismatched, missing)
+
+			if mismatched > 0 || missing > 0 {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("the Pulumi state does not match the Terraform state")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&tf, "tf", "",
+		"Path to the Terraform root folder, or directly to a .tfstate/.json state file")
+	cmd.Flags().StringVar(&pulumiSources, "pulumi-sources", ".", "Path to the Pulumi project folder")
+	cmd.Flags().StringVar(&pulumiStack, "pulumi-stack", "",
+		"Pulumi stack to compare against (default: the currently selected stack)")
+	cmd.MarkFlagRequired("tf")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newCompareStatesCmd())
+}
@@ -0,0 +1,87 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/spf13/cobra"
+)
+
+func newConvertSourceCmd() *cobra.Command {
+	var tfDir string
+	var language string
+	var out string
+	var migrationFilePath string
+
+	cmd := &cobra.Command{
+		Use:   "convert-source",
+		Short: "Convert Terraform sources into a Pulumi program",
+		Long: `Convert Terraform sources into a Pulumi program using 'pulumi convert'.
+
+This complements state migration: the tool otherwise migrates state only and leaves source
+translation to the user. Requires the 'pulumi' binary in PATH with the terraform converter
+plugin available.
+
+With --migration, the translated state is cross-referenced against the generated program and
+missing URNs in migration.json are pre-populated, so the converted sources and the migrated
+state stay in sync.
+
+Example:
+
+  pulumi-terraform-migrate convert-source --from ./infra --language typescript --out ./pulumi-infra
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := pkg.ConvertSource(cmd.Context(), tfDir, language, out); err != nil {
+				return err
+			}
+			fmt.Printf("Converted Terraform sources in %s to a %s Pulumi program in %s\n", tfDir, language, out)
+
+			if migrationFilePath == "" {
+				return nil
+			}
+
+			mf, err := migration.LoadMigration(migrationFilePath)
+			if err != nil {
+				return fmt.Errorf("failed to load migration file: %w", err)
+			}
+			resolved, err := pkg.PrepopulateMigrationURNs(cmd.Context(), mf, out)
+			if err != nil {
+				return fmt.Errorf("failed to pre-populate migration URNs: %w", err)
+			}
+			if err := mf.Save(migrationFilePath); err != nil {
+				return fmt.Errorf("failed to save migration file: %w", err)
+			}
+			fmt.Printf("Pre-populated %d resource URNs in %s\n", resolved, migrationFilePath)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&tfDir, "from", "", "Path to the Terraform sources to convert")
+	cmd.Flags().StringVarP(&language, "language", "l", "typescript", "Language of the generated Pulumi program")
+	cmd.Flags().StringVarP(&out, "out", "o", "", "Directory to write the generated Pulumi program to")
+	cmd.Flags().StringVarP(&migrationFilePath, "migration", "m", "",
+		"Optional migration.json to pre-populate with URNs from the converted program")
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("out")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newConvertSourceCmd())
+}
@@ -0,0 +1,257 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/preview"
+	"github.com/spf13/cobra"
+)
+
+func newDiffCmd() *cobra.Command {
+	var migrationFilePath string
+	var out string
+	var since string
+	var runPreview bool
+	var noRefresh bool
+	var stackNames []string
+	var allStacks bool
+	var failOn string
+	var parallelStacks int
+	var stats bool
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the migration status of every resource",
+		Long: `Show the migration status of every resource tracked by a migration.json file.
+
+For each stack, compares the resources recorded in migration.json against the Terraform state and
+reports resources that are not yet tracked, not yet translated, or marked for special handling.
+
+Use --preview to additionally run 'pulumi preview' per stack and refine the classification with
+the planned operations. By default the preview refreshes against live cloud state; --no-refresh
+skips the refresh, which is faster and works offline but may miss drift, making
+needs-update/needs-replace classification less accurate.
+
+Use --out to save the report as JSON, and --since with a previously saved report to only show
+resources whose migration.json entry or Terraform state changed since that report. When the
+previous report is missing a stack, all of its resources are shown.
+
+Example:
+
+  pulumi-terraform-migrate diff --migration migration.json --out report.json
+  pulumi-terraform-migrate diff --migration migration.json --since report.json
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch failOn {
+			case "", "needs-replace", "needs-update", "not-tracked", "any":
+			default:
+				return fmt.Errorf("invalid --fail-on %q: expected needs-replace, needs-update, not-tracked or any", failOn)
+			}
+
+			mf, err := migration.LoadMigration(migrationFilePath)
+			if err != nil {
+				return fmt.Errorf("failed to load migration file: %w", err)
+			}
+
+			var previous *migration.DiffReport
+			if since != "" {
+				previous, err = migration.LoadDiffReport(since)
+				if err != nil {
+					return fmt.Errorf("failed to load previous report: %w", err)
+				}
+			}
+
+			stacks, err := selectStacks(mf, stackNames, allStacks)
+			if err != nil {
+				return err
+			}
+
+			// Compute per-stack diffs, optionally in parallel. Results and errors are collected
+			// by stack index so the output below stays in migration.json order regardless of
+			// completion order, and a failure in one stack does not abort the others.
+			diffs := make([]*migration.StackDiff, len(stacks))
+			stackErrs := make([]error, len(stacks))
+			pkg.SetStatsEnabled(stats)
+
+			computeStack := func(i int) {
+				stack := stacks[i]
+				diff, err := migration.ComputeDiff(cmd.Context(), *stack)
+				if err != nil {
+					stackErrs[i] = fmt.Errorf("failed to compute diff for stack %s: %w", stack.PulumiStack, err)
+					return
+				}
+
+				if runPreview {
+					statuses, err := preview.Preview(cmd.Context(), mf.Migration.PulumiSources, preview.Options{
+						Stack:     stack.PulumiStack,
+						NoRefresh: noRefresh,
+					})
+					if err != nil {
+						stackErrs[i] = fmt.Errorf("failed to preview stack %s: %w", stack.PulumiStack, err)
+						return
+					}
+					diff.ApplyPreviewStatuses(statuses)
+				}
+
+				diffs[i] = diff
+			}
+
+			_ = pkg.TimePhase("diff compute", func() error {
+				if parallelStacks > 1 {
+					var wg sync.WaitGroup
+					indexChan := make(chan int, len(stacks))
+					for w := 0; w < parallelStacks; w++ {
+						wg.Add(1)
+						go func() {
+							defer wg.Done()
+							for i := range indexChan {
+								computeStack(i)
+							}
+						}()
+					}
+					for i := range stacks {
+						indexChan <- i
+					}
+					close(indexChan)
+					wg.Wait()
+				} else {
+					for i := range stacks {
+						computeStack(i)
+					}
+				}
+				return nil
+			})
+
+			report := &migration.DiffReport{}
+			for i, stack := range stacks {
+				if stackErrs[i] != nil {
+					fmt.Printf("Stack %s: %v\n", stack.PulumiStack, stackErrs[i])
+					continue
+				}
+				diff := diffs[i]
+
+				report.Stacks = append(report.Stacks, diff)
+
+				fmt.Printf("Stack %s: %d resources (%d tracked, %d not tracked, %d not translated, "+
+					"%d needs replace, %d needs update, %d skipped, %d stale)\n",
+					diff.PulumiStack, diff.Summary.Total, diff.Summary.Tracked, diff.Summary.NotTracked,
+					diff.Summary.NotTranslated, diff.Summary.NeedsReplace, diff.Summary.NeedsUpdate,
+					diff.Summary.Skipped, diff.Summary.Stale)
+
+				resources := diff.Resources
+				if previous != nil {
+					resources = migration.ChangedSince(previous.FindStack(stack.PulumiStack), diff)
+					fmt.Printf("  %d resources changed since %s\n", len(resources), since)
+				}
+
+				for _, res := range resources {
+					if previous == nil && res.Status == migration.ResourceStatusTracked {
+						continue
+					}
+					fmt.Printf("  %-15s %s\n", res.Status, res.TFAddr)
+				}
+			}
+
+			if out != "" {
+				err := pkg.TimePhase("file write", func() error {
+					return report.Save(out)
+				})
+				if err != nil {
+					return fmt.Errorf("failed to save report: %w", err)
+				}
+			}
+			pkg.PrintStats()
+
+			if err := errors.Join(stackErrs...); err != nil {
+				cmd.SilenceUsage = true
+				return err
+			}
+
+			if failOn != "" {
+				if reason := checkFailOn(failOn, report); reason != "" {
+					cmd.SilenceUsage = true
+					return fmt.Errorf("--fail-on %s: %s", failOn, reason)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&migrationFilePath, "migration", "m", "", "Path to the migration.json file")
+	cmd.Flags().StringVarP(&out, "out", "o", "", "Where to save the diff report as JSON")
+	cmd.Flags().StringVar(&since, "since", "", "Path to a previously saved report; only show resources changed since")
+	cmd.Flags().BoolVar(&runPreview, "preview", false,
+		"Run 'pulumi preview' per stack to refine needs-update/needs-replace classification")
+	cmd.Flags().BoolVar(&noRefresh, "no-refresh", false,
+		"Run the preview without refresh; faster and works offline, but classification may miss drift")
+	cmd.Flags().StringArrayVarP(&stackNames, "stack", "s", nil,
+		"Only diff this Pulumi stack; may be repeated (default: all stacks)")
+	cmd.Flags().BoolVar(&allStacks, "all-stacks", false, "Diff every stack in the migration file")
+	cmd.Flags().IntVar(&parallelStacks, "parallel-stacks", 1,
+		"Compute diffs for up to this many stacks concurrently; output order is unaffected")
+	cmd.Flags().BoolVar(&stats, "stats", false,
+		"Print wall-clock time spent in each phase to stderr")
+	cmd.Flags().StringVar(&failOn, "fail-on", "",
+		"Exit non-zero when the diff contains resources in this state: needs-replace, needs-update, not-tracked or any")
+	cmd.MarkFlagsMutuallyExclusive("stack", "all-stacks")
+	cmd.MarkFlagRequired("migration")
+
+	return cmd
+}
+
+// checkFailOn inspects the accumulated diff summaries and returns a one-line reason when the
+// --fail-on threshold is hit, or "" when the diff is clean for that threshold.
+func checkFailOn(failOn string, report *migration.DiffReport) string {
+	var total migration.DiffSummary
+	for _, stack := range report.Stacks {
+		total.NotTracked += stack.Summary.NotTracked
+		total.NotTranslated += stack.Summary.NotTranslated
+		total.NeedsReplace += stack.Summary.NeedsReplace
+		total.NeedsUpdate += stack.Summary.NeedsUpdate
+		total.Stale += stack.Summary.Stale
+	}
+
+	switch failOn {
+	case "needs-replace":
+		if total.NeedsReplace > 0 {
+			return fmt.Sprintf("%d resources need replacement", total.NeedsReplace)
+		}
+	case "needs-update":
+		if total.NeedsUpdate > 0 {
+			return fmt.Sprintf("%d resources need an update", total.NeedsUpdate)
+		}
+	case "not-tracked":
+		if total.NotTracked > 0 {
+			return fmt.Sprintf("%d resources are not tracked", total.NotTracked)
+		}
+	case "any":
+		findings := total.NotTracked + total.NotTranslated + total.NeedsReplace + total.NeedsUpdate + total.Stale
+		if findings > 0 {
+			return fmt.Sprintf("%d resources are not fully migrated", findings)
+		}
+	}
+	return ""
+}
+
+func init() {
+	rootCmd.AddCommand(newDiffCmd())
+}
@@ -0,0 +1,63 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/providermap"
+	"github.com/spf13/cobra"
+)
+
+func newExportProviderMapCmd() *cobra.Command {
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "export-provider-map",
+		Short: "Export the known bridged providers as providers.json",
+		Long: `Export the tool's known statically bridged providers in the array format of ci-mgmt's
+provider-ci/providers.json.
+
+This is an administrative command that lets maintainers diff the tool's provider coverage against
+ci-mgmt programmatically and keep the two lists in sync from either direction.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entries := providermap.ExportProviderMap()
+			bytes, err := json.MarshalIndent(entries, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal provider map: %w", err)
+			}
+			bytes = append(bytes, '\n')
+
+			if out == "" {
+				_, err := os.Stdout.Write(bytes)
+				return err
+			}
+			if err := os.WriteFile(out, bytes, 0o644); err != nil {
+				return fmt.Errorf("failed to write provider map: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&out, "out", "o", "", "Where to write providers.json (default: stdout)")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newExportProviderMapCmd())
+}
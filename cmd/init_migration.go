@@ -0,0 +1,146 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/spf13/cobra"
+)
+
+func newInitMigrationCmd() *cobra.Command {
+	var tfSources string
+	var pulumiSources string
+	var tfState string
+	var stackName string
+	var out string
+	var merge bool
+
+	cmd := &cobra.Command{
+		Use:   "init-migration",
+		Short: "Create a migration.json tracking file from Terraform state",
+		Long: `Create a migration.json tracking file from Terraform state.
+
+Lists every managed resource in the Terraform state as an untranslated entry for the given Pulumi
+stack. The file is then maintained by the other commands (resolve-import-stubs, skip, wizard) as
+the migration progresses.
+
+Re-running against an existing migration.json fails unless --merge is passed. With --merge the
+file is reconciled with the current Terraform state: newly discovered resources are added,
+entries whose resource disappeared from the state are flagged (not deleted), and user-edited URN
+mappings are left intact.
+
+Example:
+
+  pulumi-terraform-migrate init-migration \
+    --tf-sources path/to/terraform-sources \
+    --pulumi-sources path/to/pulumi-project \
+    --tf-state path/to/terraform.tfstate \
+    --stack dev
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var mf *migration.MigrationFile
+			_, statErr := os.Stat(out)
+			exists := statErr == nil
+
+			switch {
+			case exists && !merge:
+				cmd.SilenceUsage = true
+				return fmt.Errorf("%s already exists; pass --merge to reconcile it with the current state", out)
+			case exists:
+				var err error
+				mf, err = migration.LoadMigration(out)
+				if err != nil {
+					return fmt.Errorf("failed to load migration file: %w", err)
+				}
+			default:
+				mf = &migration.MigrationFile{}
+			}
+
+			if tfSources != "" {
+				mf.Migration.TFSources = tfSources
+			}
+			if pulumiSources != "" {
+				mf.Migration.PulumiSources = pulumiSources
+			}
+
+			if stackName == "" {
+				if mf.Migration.PulumiSources == "" {
+					return fmt.Errorf("pass --stack, or --pulumi-sources to detect the stack from the project")
+				}
+				detected, err := migration.DetectPulumiStack(cmd.Context(), mf.Migration.PulumiSources)
+				if err != nil {
+					cmd.SilenceUsage = true
+					return err
+				}
+				stackName = detected
+				fmt.Printf("Detected Pulumi stack %s\n", stackName)
+			}
+
+			var stack *migration.Stack
+			for i := range mf.Migration.Stacks {
+				if mf.Migration.Stacks[i].PulumiStack == stackName {
+					stack = &mf.Migration.Stacks[i]
+					break
+				}
+			}
+			if stack == nil {
+				mf.Migration.Stacks = append(mf.Migration.Stacks, migration.Stack{
+					PulumiStack: stackName,
+					TFState:     tfState,
+				})
+				stack = &mf.Migration.Stacks[len(mf.Migration.Stacks)-1]
+			}
+			if tfState != "" {
+				stack.TFState = tfState
+			}
+
+			addrs, err := migration.StackResourceAddresses(cmd.Context(), *stack)
+			if err != nil {
+				return err
+			}
+
+			summary := migration.MergeStack(stack, addrs)
+			if err := mf.Save(out); err != nil {
+				return fmt.Errorf("failed to save migration file: %w", err)
+			}
+
+			fmt.Printf("Stack %s: added %d resources, flagged %d removed resources\n",
+				stack.PulumiStack, len(summary.Added), len(summary.Removed))
+			for _, addr := range summary.Removed {
+				fmt.Printf("  removed from state: %s\n", addr)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&tfSources, "tf-sources", "", "Path to the Terraform sources")
+	cmd.Flags().StringVar(&pulumiSources, "pulumi-sources", "", "Path to the Pulumi project")
+	cmd.Flags().StringVar(&tfState, "tf-state", "", "Path to the Terraform state file for the stack")
+	cmd.Flags().StringVarP(&stackName, "stack", "s", "",
+		"Name of the Pulumi stack such as \"dev\"; detected automatically for single-stack projects")
+	cmd.Flags().StringVarP(&out, "out", "o", "migration.json", "Where to write the migration file")
+	cmd.Flags().BoolVar(&merge, "merge", false,
+		"Reconcile an existing migration file with the current Terraform state instead of failing")
+	cmd.MarkFlagRequired("tf-state")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newInitMigrationCmd())
+}
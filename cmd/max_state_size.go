@@ -0,0 +1,35 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+)
+
+// maxStateSizeFlag caps the size of Terraform states the tool will load, in bytes. Zero, the
+// default, means no limit.
+var maxStateSizeFlag int64
+
+func init() {
+	rootCmd.PersistentFlags().Int64Var(&maxStateSizeFlag, "max-state-size", 0,
+		"Refuse to load Terraform states larger than this many bytes (0 means no limit)")
+}
+
+// applyMaxStateSize installs the --max-state-size cap before any state loads.
+func applyMaxStateSize() {
+	if maxStateSizeFlag > 0 {
+		tofu.SetMaxStateSize(maxStateSizeFlag)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/spf13/cobra"
+)
+
+// nextBlocker describes the first piece of outstanding work found in a migration file, in a form
+// usable both as prose guidance and as a one-line --check reason.
+type nextBlocker struct {
+	Stack  string
+	Reason string
+	Advice string
+}
+
+// findNextBlocker walks the migration file in order and returns the first stack that still has
+// unmapped or unresolved resources, or nil when the migration is complete.
+func findNextBlocker(mf *migration.MigrationFile) *nextBlocker {
+	if len(mf.Migration.Stacks) == 0 {
+		return &nextBlocker{
+			Reason: "the migration file has no stacks",
+			Advice: "Add a stack entry mapping a Terraform state to a Pulumi stack.",
+		}
+	}
+	for _, stack := range mf.Migration.Stacks {
+		if len(stack.Resources) == 0 {
+			return &nextBlocker{
+				Stack:  stack.PulumiStack,
+				Reason: fmt.Sprintf("stack %s has no resource mappings", stack.PulumiStack),
+				Advice: "Run 'pulumi-terraform-migrate diff' to see the resources in the Terraform state, " +
+					"then record mappings in migration.json.",
+			}
+		}
+		unresolved := 0
+		for _, res := range stack.Resources {
+			if res.URN == "" && res.Migrate != migration.MigrateModeSkip &&
+				res.Migrate != migration.MigrateModeNew {
+				unresolved++
+			}
+		}
+		if unresolved > 0 {
+			return &nextBlocker{
+				Stack:  stack.PulumiStack,
+				Reason: fmt.Sprintf("stack %s has %d resources without a URN", stack.PulumiStack, unresolved),
+				Advice: "Run 'pulumi-terraform-migrate resolve-import-stubs' to fill in URNs from the Pulumi " +
+					"stack, or mark resources as skipped with 'pulumi-terraform-migrate skip'.",
+			}
+		}
+	}
+	return nil
+}
+
+// findImportIDBlocker checks the resolved import files next to the migration file for resources
+// that still have no import ID. Such files would break `pulumi import --file`, so the migration is
+// not complete while any exist.
+func findImportIDBlocker(migrationFilePath string) (*nextBlocker, error) {
+	pattern := filepath.Join(filepath.Dir(migrationFilePath), "import-resolved-*.json")
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+
+	for _, path := range matches {
+		file, err := pkg.LoadImportFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if unresolved := file.UnresolvedImportIDs(); len(unresolved) > 0 {
+			return &nextBlocker{
+				Reason: fmt.Sprintf("import file %s has %d resources without an import ID: %s",
+					path, len(unresolved), strings.Join(unresolved, ", ")),
+				Advice: "Fill in the missing IDs in the import file before running 'pulumi import --file'.",
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+func newNextCmd() *cobra.Command {
+	var migrationFilePath string
+	var check bool
+
+	cmd := &cobra.Command{
+		Use:   "next",
+		Short: "Suggest the next step of the migration",
+		Long: `Suggest the next step of the migration based on the state of a migration.json file.
+
+Walks the stacks in order and reports the first one that still has unmapped or unresolved
+resources, along with advice on how to make progress. Resolved import files next to the migration
+file are also checked for resources that still have no import ID. When every resource in every
+stack is mapped or explicitly skipped and every import file is fully resolved, the migration is
+complete.
+
+With --check, prose is suppressed and the command exits 0 only when all stacks are fully
+mapped, and non-zero otherwise with a one-line reason. This makes next usable as a CI gate.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mf, err := migration.LoadMigration(migrationFilePath)
+			if err != nil {
+				return fmt.Errorf("failed to load migration file: %w", err)
+			}
+
+			blocker := findNextBlocker(mf)
+			if blocker == nil {
+				blocker, err = findImportIDBlocker(migrationFilePath)
+				if err != nil {
+					return fmt.Errorf("failed to check import files: %w", err)
+				}
+			}
+
+			if check {
+				if blocker != nil {
+					cmd.SilenceUsage = true
+					return fmt.Errorf("migration incomplete: %s", blocker.Reason)
+				}
+				return nil
+			}
+
+			if blocker == nil {
+				fmt.Println("All stacks are fully mapped. STOP: the migration is complete.")
+				return nil
+			}
+			fmt.Printf("Next: %s.\n%s\n", blocker.Reason, blocker.Advice)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&migrationFilePath, "migration", "m", "", "Path to the migration.json file")
+	cmd.Flags().BoolVar(&check, "check", false,
+		"Exit 0 only when all stacks are fully mapped; non-zero otherwise with a one-line reason")
+	cmd.MarkFlagRequired("migration")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newNextCmd())
+}
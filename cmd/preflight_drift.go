@@ -0,0 +1,68 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+	"github.com/spf13/cobra"
+)
+
+func newPreflightDriftCmd() *cobra.Command {
+	var from string
+
+	cmd := &cobra.Command{
+		Use:   "preflight-drift",
+		Short: "Check for drift between the Terraform state and live infrastructure",
+		Long: `Check for drift between the Terraform state and live infrastructure before migrating.
+
+Runs a read-only 'tofu plan' and reports the resources that would change on a Terraform apply.
+Migrating a drifted state bakes the drift into the Pulumi stack, so reconcile first with
+'tofu apply' or 'tofu refresh' when drift is reported.
+
+The plan's refresh phase may require authorizing read-only access to the cloud accounts. The
+tool never runs mutating commands such as 'tofu apply'.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := tofu.DetectDrift(cmd.Context(), from)
+			if err != nil {
+				return fmt.Errorf("failed to check for drift: %w", err)
+			}
+
+			if !report.HasDrift() {
+				fmt.Println("No drift detected: the Terraform state matches the live infrastructure.")
+				return nil
+			}
+
+			fmt.Printf("%d resources would change on a Terraform apply:\n", len(report.ChangedAddresses))
+			for _, addr := range report.ChangedAddresses {
+				fmt.Printf("  %s\n", addr)
+			}
+			fmt.Println("Reconcile the drift with 'tofu apply' or 'tofu refresh' before migrating.")
+			cmd.SilenceUsage = true
+			return fmt.Errorf("drift detected in %d resources", len(report.ChangedAddresses))
+		},
+	}
+
+	cmd.Flags().StringVarP(&from, "from", "f", "", "Path to the Terraform root folder")
+	cmd.MarkFlagRequired("from")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newPreflightDriftCmd())
+}
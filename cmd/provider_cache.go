@@ -0,0 +1,36 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tfprovider"
+)
+
+// providerCacheDir overrides where downloaded TF providers are cached for this run. It takes
+// precedence over the PULUMI_DYNAMIC_TF_PLUGIN_CACHE_DIR environment variable, so CI jobs can
+// point a single run at a mounted cache volume without touching the environment.
+var providerCacheDir string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&providerCacheDir, "provider-cache-dir", "",
+		"Cache downloaded TF providers in this directory (overrides PULUMI_DYNAMIC_TF_PLUGIN_CACHE_DIR)")
+}
+
+// applyProviderCacheDir installs the --provider-cache-dir override before any provider loads.
+func applyProviderCacheDir() {
+	if providerCacheDir != "" {
+		tfprovider.SetCacheDirOverride(providerCacheDir)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+)
+
+// providerSchemaDirFlag points at a directory of pre-exported provider mapping files named
+// <provider>.json, for translating without network access. Export one with
+// `pulumi package get-mapping terraform <provider>`.
+var providerSchemaDirFlag string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&providerSchemaDirFlag, "provider-schema-dir", "",
+		"Directory of pre-exported <provider>.json mapping files to use instead of installing providers")
+}
+
+// applyProviderSchemaDir installs the --provider-schema-dir override before any provider loads.
+func applyProviderSchemaDir() {
+	if providerSchemaDirFlag != "" {
+		pkg.SetProviderSchemaDir(providerSchemaDirFlag)
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/bridgedproviders"
+)
+
+// providerTimeoutFlag bounds individual provider operations such as schema fetches and plugin
+// downloads, separately from the overall --timeout. Zero, the default, means no limit.
+var providerTimeoutFlag time.Duration
+
+func init() {
+	rootCmd.PersistentFlags().DurationVar(&providerTimeoutFlag, "provider-timeout", 0,
+		"Abort a single provider schema fetch or plugin download after this duration, e.g. 2m (default: no timeout)")
+}
+
+// applyProviderTimeout installs the --provider-timeout limit before any providers are fetched.
+func applyProviderTimeout() {
+	if providerTimeoutFlag > 0 {
+		bridgedproviders.SetProviderTimeout(providerTimeoutFlag)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+	"github.com/spf13/cobra"
+)
+
+func newProvidersCmd() *cobra.Command {
+	var from string
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "providers",
+		Short: "Show what each resource in a Terraform state will migrate to",
+		Long: `Show what each resource in a Terraform state will migrate to.
+
+For every managed resource, prints the Terraform provider, the Pulumi provider it resolves to,
+the expected Pulumi type token and the inferred import ID. This is a dry-run planning view: no
+providers are installed and no Pulumi project is required, unlike 'diff' which compares against
+an existing migration. Type tokens reflect the bridge's default naming; providers that override
+tokens may map some resources differently.
+
+Example:
+
+  pulumi-terraform-migrate providers --from path/to/terraform-sources
+  pulumi-terraform-migrate providers --from terraform.tfstate --output json
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "" && output != "json" {
+				return fmt.Errorf("invalid --output %q: expected json", output)
+			}
+
+			loadOpts, err := tofu.StateInputOptions(from)
+			if err != nil {
+				return err
+			}
+			tfState, err := tofu.LoadTerraformState(cmd.Context(), loadOpts)
+			if err != nil {
+				return fmt.Errorf("failed to load Terraform state: %w", err)
+			}
+
+			entries, err := pkg.ProviderPlan(tfState)
+			if err != nil {
+				return fmt.Errorf("failed to compute provider plan: %w", err)
+			}
+
+			if output == "json" {
+				bytes, err := json.MarshalIndent(entries, "", "  ")
+				if err != nil {
+					return fmt.Errorf("failed to marshal provider plan: %w", err)
+				}
+				fmt.Println(string(bytes))
+				return nil
+			}
+
+			for _, entry := range entries {
+				provider := entry.PulumiProvider
+				if entry.Dynamic {
+					provider += " (dynamic)"
+				}
+				importID := entry.ImportID
+				if importID == "" {
+					importID = "(cannot infer import ID)"
+				}
+				fmt.Printf("%s\n  terraform provider: %s\n  pulumi provider:    %s\n",
+					entry.TFAddr, entry.TerraformProvider, provider)
+				if entry.PulumiType != "" {
+					fmt.Printf("  pulumi type:        %s\n", entry.PulumiType)
+				}
+				fmt.Printf("  import id:          %s\n", importID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&from, "from", "f", "",
+		"Path to the Terraform root folder, or directly to a .tfstate/.json state file")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format; supports json")
+	cmd.MarkFlagRequired("from")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newProvidersCmd())
+}
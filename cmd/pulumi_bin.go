@@ -0,0 +1,36 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+)
+
+// pulumiBin pins the Pulumi CLI binary used for preview and import, for users with multiple
+// Pulumi versions installed. Empty means whatever `pulumi` is in PATH.
+var pulumiBin string
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&pulumiBin, "pulumi-bin", "",
+		"Path to the pulumi binary to use (default: the pulumi found in PATH)")
+}
+
+// applyPulumiBin validates and installs the --pulumi-bin override before any pulumi invocations.
+func applyPulumiBin() error {
+	if pulumiBin == "" {
+		return nil
+	}
+	return pkg.SetPulumiBinary(pulumiBin)
+}
@@ -0,0 +1,35 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/providermap"
+)
+
+// requireExactRegistryHostFlag disables the registry host normalization that lets states from
+// private or mirrored registries resolve their providers.
+var requireExactRegistryHostFlag bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&requireExactRegistryHostFlag, "require-exact-registry-host", false,
+		"Only map providers whose source address uses registry.terraform.io or registry.opentofu.org, "+
+			"instead of ignoring the registry host")
+}
+
+// applyRequireExactRegistryHost installs the --require-exact-registry-host setting before any
+// provider lookups.
+func applyRequireExactRegistryHost() {
+	providermap.SetRequireExactRegistryHost(requireExactRegistryHostFlag)
+}
@@ -0,0 +1,76 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/spf13/cobra"
+)
+
+func newReportCmd() *cobra.Command {
+	var migrationFilePath string
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Produce a Markdown migration report",
+		Long: `Produce a shareable Markdown report summarizing the migration.
+
+For each stack in the migration.json file, the report includes the migration status counts, the
+resources that are not yet tracked, not yet translated, or need replacement, and a table of the
+Terraform providers in use. The numbers match the output of the diff command exactly.
+
+Example:
+
+  pulumi-terraform-migrate report --migration migration.json --out report.md
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mf, err := migration.LoadMigration(migrationFilePath)
+			if err != nil {
+				return fmt.Errorf("failed to load migration file: %w", err)
+			}
+
+			var diffs []*migration.StackDiff
+			for _, stack := range mf.Migration.Stacks {
+				diff, err := migration.ComputeDiff(cmd.Context(), stack)
+				if err != nil {
+					return fmt.Errorf("failed to compute diff: %w", err)
+				}
+				diffs = append(diffs, diff)
+			}
+
+			report := migration.RenderMarkdownReport(diffs)
+			if err := os.WriteFile(out, []byte(report), 0644); err != nil {
+				return fmt.Errorf("failed to write report: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&migrationFilePath, "migration", "m", "", "Path to the migration.json file")
+	cmd.Flags().StringVarP(&out, "out", "o", "", "Where to write the Markdown report")
+	cmd.MarkFlagRequired("migration")
+	cmd.MarkFlagRequired("out")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newReportCmd())
+}
@@ -0,0 +1,136 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/spf13/cobra"
+)
+
+// stubResolution is the per-stack result of resolve-import-stubs, serialized as-is in JSON output
+// mode so automation can consume it.
+type stubResolution struct {
+	PulumiStack string                     `json:"pulumi-stack"`
+	Resolved    int                        `json:"resolved"`
+	Unresolved  []migration.UnresolvedStub `json:"unresolved,omitempty"`
+
+	// PulumiNative counts entries marked migrate: "new", which are Pulumi-native and need no
+	// import.
+	PulumiNative int `json:"pulumi-native,omitempty"`
+}
+
+func newResolveImportStubsCmd() *cobra.Command {
+	var migrationFilePath string
+	var stackNames []string
+	var allStacks bool
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "resolve-import-stubs",
+		Short: "Resolve migration.json entries that are missing URNs",
+		Long: `Resolve migration.json entries that are missing URNs.
+
+Entries without a URN ("import stubs") are matched against the resources in the selected Pulumi
+stack. Entries with exactly one match are filled in and saved back to migration.json. Entries that
+cannot be resolved are reported with partial-match suggestions and example migration.json entries.
+
+With --output json the report is emitted as structured JSON instead of formatted text, so
+automation can consume the unresolved stubs and suggestions.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != "text" && output != "json" {
+				return fmt.Errorf("invalid --output %q: expected text or json", output)
+			}
+
+			mf, err := migration.LoadMigration(migrationFilePath)
+			if err != nil {
+				return fmt.Errorf("failed to load migration file: %w", err)
+			}
+
+			result, err := pkg.GetDeployment(mf.Migration.PulumiSources)
+			if err != nil {
+				return fmt.Errorf("failed to export Pulumi stack: %w", err)
+			}
+
+			var urns []string
+			for _, res := range result.Deployment.Resources {
+				urns = append(urns, string(res.URN))
+			}
+
+			stacks, err := selectStacks(mf, stackNames, allStacks)
+			if err != nil {
+				return err
+			}
+
+			var resolutions []stubResolution
+			for _, st := range stacks {
+				resolved, unresolved := migration.ResolveImportStubs(st, urns)
+				native := 0
+				for _, res := range st.Resources {
+					if res.Migrate == migration.MigrateModeNew {
+						native++
+					}
+				}
+				resolutions = append(resolutions, stubResolution{
+					PulumiStack:  st.PulumiStack,
+					Resolved:     resolved,
+					Unresolved:   unresolved,
+					PulumiNative: native,
+				})
+			}
+
+			if err := mf.Save(migrationFilePath); err != nil {
+				return fmt.Errorf("failed to save migration file: %w", err)
+			}
+
+			if output == "json" {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(resolutions)
+			}
+
+			for _, r := range resolutions {
+				fmt.Printf("Stack %s: resolved %d resources\n", r.PulumiStack, r.Resolved)
+				if r.PulumiNative > 0 {
+					fmt.Printf("  %d resources are Pulumi-native, no import needed\n", r.PulumiNative)
+				}
+				if len(r.Unresolved) > 0 {
+					fmt.Print(migration.FormatUnresolvedStubs(r.PulumiStack, r.Unresolved))
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&migrationFilePath, "migration", "m", "", "Path to the migration.json file")
+	cmd.Flags().StringArrayVarP(&stackNames, "stack", "s", nil,
+		"Only resolve stubs in this Pulumi stack; may be repeated (default: all stacks)")
+	cmd.Flags().BoolVar(&allStacks, "all-stacks", false, "Resolve stubs in every stack in the migration file")
+	cmd.MarkFlagsMutuallyExclusive("stack", "all-stacks")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "Output format: text or json")
+	cmd.MarkFlagRequired("migration")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newResolveImportStubsCmd())
+}
@@ -15,6 +15,7 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -29,13 +30,30 @@ It reads a terraform state file and, given an initialized Pulumi program, it pro
 
 The state can be imported via "pulumi stack import --file <pulumi-state-file>".
 `,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		applyTimeout(cmd)
+		applyProviderTimeout()
+		applyProviderCacheDir()
+		applyProviderSchemaDir()
+		applyMaxStateSize()
+		applyThreads()
+		applyAssumeYes()
+		applyRequireExactRegistryHost()
+		return applyPulumiBin()
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	err := rootCmd.Execute()
+	if timeoutCancel != nil {
+		timeoutCancel()
+	}
 	if err != nil {
+		if timedOut() {
+			fmt.Fprintf(os.Stderr, "Error: operation timed out after %s\n", commandTimeout)
+		}
 		os.Exit(1)
 	}
 }
@@ -26,6 +26,7 @@ var (
 	stateFilePath string
 	projectDir    string
 	workspace     string
+	initUpgrade   bool
 )
 
 var showStateCmd = &cobra.Command{
@@ -64,6 +65,7 @@ Examples:
 			StateFilePath: stateFilePath,
 			ProjectDir:    projectDir,
 			Workspace:     workspace,
+			InitUpgrade:   initUpgrade,
 		}
 
 		state, err := tofu.LoadTerraformState(cmd.Context(), opts)
@@ -88,4 +90,6 @@ func init() {
 	showStateCmd.Flags().StringVar(&stateFilePath, "state-file", "", "Path to the explicit terraform.tfstate file")
 	showStateCmd.Flags().StringVar(&projectDir, "project-dir", "", "Path to the root directory where Terraform sources are located")
 	showStateCmd.Flags().StringVar(&workspace, "workspace", "", "Terraform/OpenTOFU workspace to load (requires --project-dir)")
+	showStateCmd.Flags().BoolVar(&initUpgrade, "init-upgrade", false,
+		"Retry 'tofu init' with -upgrade when a stale lock file causes a module source change error")
 }
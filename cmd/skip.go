@@ -0,0 +1,102 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/spf13/cobra"
+)
+
+// maxSkipWithoutConfirm is the number of glob matches above which skip requires --yes, to avoid
+// accidental mass-skips from an overly broad pattern.
+const maxSkipWithoutConfirm = 10
+
+func newSkipCmd() *cobra.Command {
+	var migrationFilePath string
+	var stack string
+	var addr string
+	var reason string
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "skip",
+		Short: "Mark a resource as skipped in the migration",
+		Long: `Mark a resource as skipped in a migration.json file.
+
+Skipped resources are excluded from migration checks. The optional --reason is recorded in
+migration.json next to the decision so it is reviewable later.
+
+The address may contain "*" wildcards to skip whole subtrees at once; patterns are matched against
+the addresses in the stack's Terraform state as well as addresses already tracked in
+migration.json. When a pattern matches more than 10 resources, --yes is required.
+
+Example:
+
+  pulumi-terraform-migrate skip \
+    --migration migration.json \
+    --addr aws_instance.legacy \
+    --reason "decommissioned after the migration"
+
+  pulumi-terraform-migrate skip \
+    --migration migration.json \
+    --addr 'module.legacy.*' \
+    --yes
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mf, err := migration.LoadMigration(migrationFilePath)
+			if err != nil {
+				return fmt.Errorf("failed to load migration file: %w", err)
+			}
+
+			if strings.Contains(addr, "*") {
+				matched, err := mf.SkipMatchingResources(cmd.Context(), stack, addr, reason)
+				if err != nil {
+					return err
+				}
+				if len(matched) > maxSkipWithoutConfirm && !yes && !assumeYes() {
+					return fmt.Errorf(
+						"pattern %q matches %d resources; re-run with --yes to confirm skipping all of them",
+						addr, len(matched))
+				}
+				fmt.Printf("Skipped %d resources matching %q\n", len(matched), addr)
+			} else if err := mf.SkipResource(stack, addr, reason); err != nil {
+				return err
+			}
+
+			if err := mf.Save(migrationFilePath); err != nil {
+				return fmt.Errorf("failed to save migration file: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&migrationFilePath, "migration", "m", "", "Path to the migration.json file")
+	cmd.Flags().StringVarP(&stack, "stack", "s", "", "Only skip the resource in this Pulumi stack (default: all stacks)")
+	cmd.Flags().StringVarP(&addr, "addr", "a", "", "Terraform address of the resource to skip")
+	cmd.Flags().StringVarP(&reason, "reason", "r", "", "Why the resource is skipped; recorded in migration.json")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Confirm skipping when a pattern matches many resources")
+	cmd.MarkFlagRequired("migration")
+	cmd.MarkFlagRequired("addr")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newSkipCmd())
+}
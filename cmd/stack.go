@@ -15,18 +15,40 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
 	"github.com/spf13/cobra"
 )
 
 func newStackCmd() *cobra.Command {
 	var from string
 	var out string
+	var outputDir string
 	var to string
 	var plugins string
 	var strict bool
+	var strictProviderVersion bool
+	var excludeFile string
+	var mappingOnly bool
+	var includeDeposed bool
+	var stateVersion int
+	var stats bool
+	var confirm bool
+	var yes bool
+	var dryRun bool
+	var providerConfigOut string
+	var namingStrategy string
+	var failFast bool
+	var continueOnProviderError bool
+	var escProviderConfig bool
+	var emitStackReferences bool
+	var pluginsYAML string
+	var pins []string
 
 	cmd := &cobra.Command{
 		Use:   "stack",
@@ -68,7 +90,58 @@ See also:
   https://www.pulumi.com/docs/iac/cli/commands/pulumi_plugin_install/
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := pkg.TranslateAndWriteState(cmd.Context(), from, to, out, plugins, strict)
+			if err := pkg.ValidateStateVersion(stateVersion); err != nil {
+				cmd.SilenceUsage = true
+				return err
+			}
+			if err := pkg.SetNamingStrategy(pkg.NamingStrategy(namingStrategy)); err != nil {
+				cmd.SilenceUsage = true
+				return err
+			}
+			if mappingOnly {
+				return writeTypeMappings(cmd, from, out)
+			}
+			if to == "" {
+				return fmt.Errorf(`required flag(s) "to" not set`)
+			}
+			if out == "-" && plugins == "-" {
+				return fmt.Errorf("only one of --out and --plugins can write to stdout")
+			}
+			if excludeFile != "" {
+				pkg.SetExcludeFile(excludeFile)
+			}
+			if providerConfigOut != "" {
+				pkg.SetProviderConfigFile(providerConfigOut)
+			}
+			if pluginsYAML != "" {
+				pkg.SetPluginsYAMLFile(pluginsYAML)
+			}
+			if len(pins) > 0 {
+				pinned := make(map[string]string, len(pins))
+				for _, pin := range pins {
+					name, version, ok := strings.Cut(pin, "=")
+					if !ok || name == "" || version == "" {
+						return fmt.Errorf("invalid --pin %q: expected name=version", pin)
+					}
+					pinned[name] = version
+				}
+				pkg.SetPluginPins(pinned)
+			}
+			pkg.SetIncludeDeposed(includeDeposed)
+			pkg.SetFailFast(failFast)
+			pkg.SetContinueOnProviderError(continueOnProviderError)
+			pkg.SetDeferProviderCredentials(escProviderConfig)
+			pkg.SetEmitStackReferences(emitStackReferences)
+			pkg.SetStatsEnabled(stats)
+			pkg.SetConfirmWrites(confirm && !yes)
+			pkg.SetDryRun(dryRun)
+			var err error
+			if outputDir != "" {
+				err = pkg.TranslateAndWriteOutputDir(cmd.Context(), from, to, outputDir, strict, strictProviderVersion)
+			} else {
+				err = pkg.TranslateAndWriteState(cmd.Context(), from, to, out, plugins, strict, strictProviderVersion)
+			}
+			pkg.PrintStats()
 			if err != nil {
 				return fmt.Errorf("failed to convert and write Terraform state: %w", err)
 			}
@@ -76,19 +149,89 @@ See also:
 		},
 	}
 
-	cmd.Flags().StringVarP(&from, "from", "f", "", "Path to the Terraform root folder")
+	cmd.Flags().StringVarP(&from, "from", "f", "",
+		"Path to the Terraform root folder, or directly to a .tfstate/.json state file")
 	cmd.Flags().StringVarP(&to, "to", "t", "", "Path to the Pulumi project folder")
-	cmd.Flags().StringVarP(&out, "out", "o", "", "Where to emit the translated Pulumi stack file")
-	cmd.Flags().StringVarP(&plugins, "plugins", "p", "", "Where to emit plugin requirements")
+	cmd.Flags().StringVarP(&out, "out", "o", "", "Where to emit the translated Pulumi stack file; use - for stdout")
+	cmd.Flags().StringVar(&outputDir, "output-dir", "",
+		"Directory to write state.json, required-providers.json, skipped.json and summary.txt into; replaces --out and --plugins")
+	cmd.Flags().StringVarP(&plugins, "plugins", "p", "", "Where to emit plugin requirements; use - for stdout")
 	cmd.Flags().BoolVarP(&strict, "strict", "s", false, "Fail if any resources fail to be translated")
+	cmd.Flags().BoolVar(&failFast, "fail-fast", false,
+		"Stop at the first resource that fails to translate instead of accumulating errors in the skipped list")
+	cmd.Flags().BoolVar(&continueOnProviderError, "continue-on-provider-error", false,
+		"Skip all resources of a provider that fails to install or load instead of aborting the translation")
+	cmd.Flags().BoolVar(&escProviderConfig, "esc-provider-config", false,
+		"Omit credential attributes from synthesized provider config so imported providers pick them "+
+			"up from a Pulumi ESC environment or environment variables")
+	cmd.Flags().BoolVar(&emitStackReferences, "emit-stack-references", false,
+		"Translate terraform_remote_state data sources into Pulumi StackReference resources "+
+			"instead of only reporting them in the skipped list")
+	cmd.Flags().StringVar(&excludeFile, "exclude-file", "",
+		"File listing tf-addr glob patterns to exclude from translation, one per line")
+	cmd.Flags().BoolVar(&strictProviderVersion, "strict-provider-version", false,
+		"Fail if the state was written by a provider version newer than the bridged provider supports")
+	cmd.Flags().BoolVar(&stats, "stats", false,
+		"Print wall-clock time spent in each translation phase to stderr")
+	cmd.Flags().BoolVar(&confirm, "confirm", false,
+		"Print a summary and ask for confirmation before writing any output files")
+	cmd.Flags().BoolVar(&yes, "yes", false,
+		"Answer yes to the --confirm prompt automatically")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false,
+		"Run the translation and report what would be written without writing any files")
+	cmd.Flags().IntVar(&stateVersion, "state-version", pkg.SupportedStateVersion,
+		"Deployment schema version of the emitted stack file; only version 3 is supported")
+	cmd.Flags().BoolVar(&includeDeposed, "include-deposed", false,
+		"Report deposed resource instances in the skipped list instead of ignoring them")
+	cmd.Flags().BoolVar(&mappingOnly, "mapping-only", false,
+		"Only emit the tf-addr to Pulumi type mapping as JSON; skips state conversion entirely")
+	cmd.Flags().StringVar(&providerConfigOut, "include-provider-config", "",
+		"Also write the synthesized pulumi:providers:* inputs and outputs to this file as JSON")
+	cmd.Flags().StringVar(&pluginsYAML, "plugins-yaml", "",
+		"Also write the required providers as a Pulumi.yaml plugins block to this file")
+	cmd.Flags().StringArrayVar(&pins, "pin", nil,
+		"Override a provider version in the --plugins-yaml output as name=version; may be repeated")
+	cmd.Flags().StringVar(&namingStrategy, "naming-strategy", string(pkg.NamingStrategyDefault),
+		"How to derive Pulumi resource names from Terraform addresses: default, strip-modules or kebab")
 
 	cmd.MarkFlagRequired("from")
-	cmd.MarkFlagRequired("to")
-	cmd.MarkFlagRequired("out")
+	cmd.MarkFlagsOneRequired("out", "output-dir")
+	cmd.MarkFlagsMutuallyExclusive("out", "output-dir")
+	cmd.MarkFlagsMutuallyExclusive("plugins", "output-dir")
+	cmd.MarkFlagsMutuallyExclusive("mapping-only", "output-dir")
+	cmd.MarkFlagsMutuallyExclusive("mapping-only", "plugins")
+	cmd.MarkFlagsMutuallyExclusive("mapping-only", "include-provider-config")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "confirm")
 
 	return cmd
 }
 
+// writeTypeMappings implements the --mapping-only mode: it loads the Terraform state and writes
+// the expected tf-addr to Pulumi type mapping to the --out file as JSON, skipping state conversion
+// and import-ID inference.
+func writeTypeMappings(cmd *cobra.Command, from, out string) error {
+	loadOpts, err := tofu.StateInputOptions(from)
+	if err != nil {
+		return err
+	}
+	tfState, err := tofu.LoadTerraformState(cmd.Context(), loadOpts)
+	if err != nil {
+		return fmt.Errorf("failed to load Terraform state: %w", err)
+	}
+	mappings, err := pkg.TypeMappings(tfState)
+	if err != nil {
+		return fmt.Errorf("failed to compute type mappings: %w", err)
+	}
+	data, err := json.MarshalIndent(mappings, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write type mappings: %w", err)
+	}
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(newStackCmd())
 }
@@ -0,0 +1,64 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+)
+
+// selectStacks resolves a --stack/--all-stacks selection against the stacks in a migration file.
+// With no names (or allStacks set) every stack is selected. Named stacks are returned in
+// migration-file order; naming a stack that is not in the file is an error listing the valid
+// names.
+func selectStacks(mf *migration.MigrationFile, names []string, allStacks bool) ([]*migration.Stack, error) {
+	if allStacks || len(names) == 0 {
+		stacks := make([]*migration.Stack, len(mf.Migration.Stacks))
+		for i := range mf.Migration.Stacks {
+			stacks[i] = &mf.Migration.Stacks[i]
+		}
+		return stacks, nil
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	var stacks []*migration.Stack
+	valid := make([]string, 0, len(mf.Migration.Stacks))
+	for i := range mf.Migration.Stacks {
+		stack := &mf.Migration.Stacks[i]
+		valid = append(valid, stack.PulumiStack)
+		if wanted[stack.PulumiStack] {
+			stacks = append(stacks, stack)
+			delete(wanted, stack.PulumiStack)
+		}
+	}
+
+	if len(wanted) > 0 {
+		var missing []string
+		for name := range wanted {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("no stack named %s in the migration file; valid stacks: %s",
+			strings.Join(missing, ", "), strings.Join(valid, ", "))
+	}
+	return stacks, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/spf13/cobra"
+)
+
+func newSyncURNsCmd() *cobra.Command {
+	var migrationFilePath string
+	var stackNames []string
+	var allStacks bool
+
+	cmd := &cobra.Command{
+		Use:   "sync-urns",
+		Short: "Record the URNs of imported resources back into migration.json",
+		Long: `Record the URNs of imported resources back into migration.json.
+
+After a stack import, the imported Pulumi stack holds the actual URNs. This command exports each
+selected stack, confirms the URNs already recorded in migration.json, fills in missing URNs where
+exactly one stack resource matches the name derived from the Terraform address, and reports
+recorded URNs that no longer exist in the stack.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mf, err := migration.LoadMigration(migrationFilePath)
+			if err != nil {
+				return fmt.Errorf("failed to load migration file: %w", err)
+			}
+
+			stacks, err := selectStacks(mf, stackNames, allStacks)
+			if err != nil {
+				return err
+			}
+
+			for _, st := range stacks {
+				result, err := pkg.GetDeploymentForStack(mf.Migration.PulumiSources, st.PulumiStack)
+				if err != nil {
+					return fmt.Errorf("failed to export Pulumi stack %s: %w", st.PulumiStack, err)
+				}
+
+				var urns []string
+				for _, res := range result.Deployment.Resources {
+					urns = append(urns, string(res.URN))
+				}
+
+				sync := migration.SyncURNs(st, urns)
+				fmt.Printf("Stack %s: confirmed %d URNs, filled in %d\n",
+					st.PulumiStack, sync.Confirmed, sync.Filled)
+				for _, tfAddr := range sync.Stale {
+					fmt.Printf("Warning: %s has a recorded URN that no longer exists in stack %s\n",
+						tfAddr, st.PulumiStack)
+				}
+			}
+
+			if err := mf.Save(migrationFilePath); err != nil {
+				return fmt.Errorf("failed to save migration file: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&migrationFilePath, "migration", "m", "", "Path to the migration.json file")
+	cmd.Flags().StringArrayVarP(&stackNames, "stack", "s", nil,
+		"Only sync this Pulumi stack; may be repeated (default: all stacks)")
+	cmd.Flags().BoolVar(&allStacks, "all-stacks", false, "Sync every stack in the migration file")
+	cmd.MarkFlagsMutuallyExclusive("stack", "all-stacks")
+	cmd.MarkFlagRequired("migration")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newSyncURNsCmd())
+}
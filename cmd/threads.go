@@ -0,0 +1,35 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tfprovider"
+)
+
+// threadsFlag caps how many Terraform provider plugin processes run concurrently. Zero, the
+// default, means no limit.
+var threadsFlag int
+
+func init() {
+	rootCmd.PersistentFlags().IntVar(&threadsFlag, "threads", 0,
+		"Limit the number of concurrently running Terraform provider plugin processes (0 means no limit)")
+}
+
+// applyThreads installs the --threads limit before any providers are loaded.
+func applyThreads() {
+	if threadsFlag > 0 {
+		tfprovider.SetMaxProviderProcesses(threadsFlag)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// commandTimeout bounds the whole command, including any tofu/pulumi subprocesses it spawns. Zero
+// means no timeout.
+var commandTimeout time.Duration
+
+// timeoutCtx is the context installed by the --timeout flag; Execute inspects it after a command
+// fails to distinguish a deadline from an ordinary error.
+var timeoutCtx context.Context
+
+// timeoutCancel releases the timeout context once the command finishes.
+var timeoutCancel context.CancelFunc
+
+func init() {
+	rootCmd.PersistentFlags().DurationVar(&commandTimeout, "timeout", 0,
+		"Abort the command after this duration, e.g. 10m (default: no timeout)")
+}
+
+// applyTimeout installs the --timeout deadline on the command's context. Subcommands run
+// tofu/pulumi through cmd.Context(), so cancelling it on deadline also kills the subprocess.
+func applyTimeout(cmd *cobra.Command) {
+	if commandTimeout <= 0 {
+		return
+	}
+	timeoutCtx, timeoutCancel = context.WithTimeout(cmd.Context(), commandTimeout)
+	cmd.SetContext(timeoutCtx)
+}
+
+// timedOut reports whether a failed command hit the --timeout deadline.
+func timedOut() bool {
+	return timeoutCtx != nil && timeoutCtx.Err() == context.DeadlineExceeded
+}
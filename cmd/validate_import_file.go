@@ -0,0 +1,82 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/spf13/cobra"
+)
+
+func newValidateImportFileCmd() *cobra.Command {
+	var file string
+	var pulumiSources string
+
+	cmd := &cobra.Command{
+		Use:   "validate-import-file",
+		Short: "Validate an import file before running pulumi import",
+		Long: `Validate an import file before running pulumi import.
+
+Checks that every resource has a resolved import ID, that type tokens are well-formed and served
+by a provider known to the project, that names are unique per type, and that provider and parent
+references resolve through the name table. All problems are reported at once.
+
+With --pulumi-sources the name table is additionally checked against the currently selected
+stack's state, catching URNs that point at resources the stack does not have.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			importFile, err := pkg.LoadImportFile(file)
+			if err != nil {
+				cmd.SilenceUsage = true
+				return err
+			}
+
+			var deployment *apitype.DeploymentV3
+			if pulumiSources != "" {
+				result, err := pkg.GetDeployment(pulumiSources)
+				if err != nil {
+					cmd.SilenceUsage = true
+					return fmt.Errorf("failed to export Pulumi stack: %w", err)
+				}
+				deployment = &result.Deployment
+			}
+
+			problems := pkg.ValidateImportFile(importFile, deployment)
+			if len(problems) == 0 {
+				fmt.Printf("%s is valid: %d resources checked\n", file, len(importFile.Resources))
+				return nil
+			}
+
+			for _, problem := range problems {
+				fmt.Println(problem)
+			}
+			cmd.SilenceUsage = true
+			return fmt.Errorf("import file has %d problems", len(problems))
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Path to the import file to validate")
+	cmd.Flags().StringVar(&pulumiSources, "pulumi-sources", "",
+		"Path to the Pulumi project folder to check name-table URNs against")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newValidateImportFileCmd())
+}
@@ -0,0 +1,96 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+	"github.com/spf13/cobra"
+)
+
+func newWarmupCmd() *cobra.Command {
+	var from string
+
+	cmd := &cobra.Command{
+		Use:   "warmup",
+		Short: "Prefetch the provider binaries and mappings a translation will need",
+		Long: `Prefetch the provider binaries and mappings a translation will need.
+
+Enumerates the providers used by the Terraform state, resolves their Pulumi counterparts and
+downloads each provider with its mapping data, populating the caches the 'stack' command reads.
+Running warmup first separates the slow network phase from the translation, which then runs
+without mid-run stalls and is reproducible offline.
+
+Example:
+
+  pulumi-terraform-migrate warmup --from path/to/terraform-sources
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			loadOpts, err := tofu.StateInputOptions(from)
+			if err != nil {
+				return err
+			}
+			tfState, err := tofu.LoadTerraformState(cmd.Context(), loadOpts)
+			if err != nil {
+				return fmt.Errorf("failed to load Terraform state: %w", err)
+			}
+
+			var providerVersions map[string]string
+			if loadOpts.ProjectDir != "" {
+				versions, err := tofu.GetProviderVersions(cmd.Context(), from)
+				if err == nil {
+					providerVersions = versions.ProviderSelections
+				}
+			}
+
+			results, err := pkg.WarmupProviders(cmd.Context(), tfState, providerVersions)
+			if err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, result := range results {
+				if result.Err != nil {
+					failed++
+					fmt.Printf("%-55s failed after %s: %v\n",
+						result.TerraformProvider, result.Duration.Round(time.Millisecond), result.Err)
+					continue
+				}
+				fmt.Printf("%-55s %s %s in %s\n",
+					result.TerraformProvider, result.PulumiProvider, result.Version,
+					result.Duration.Round(time.Millisecond))
+			}
+
+			if failed > 0 {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("failed to warm up %d of %d providers", failed, len(results))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&from, "from", "f", "",
+		"Path to the Terraform root folder, or directly to a .tfstate/.json state file")
+	cmd.MarkFlagRequired("from")
+
+	return cmd
+}
+
+func init() {
+	rootCmd.AddCommand(newWarmupCmd())
+}
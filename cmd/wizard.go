@@ -0,0 +1,165 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/spf13/cobra"
+)
+
+func newWizardCmd() *cobra.Command {
+	var migrationFilePath string
+
+	cmd := &cobra.Command{
+		Use:   "wizard",
+		Short: "Resolve the migration interactively",
+		Long: `Resolve the migration interactively.
+
+Runs the same resolution as resolve-import-stubs, then walks the remaining unresolved resources
+one by one, prompting for each: pick one of the suggested URNs, enter a URN manually, or skip the
+resource. Every choice is saved to migration.json immediately, so the wizard can be interrupted
+and resumed and its results stay consistent with the non-interactive commands.
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mf, err := migration.LoadMigration(migrationFilePath)
+			if err != nil {
+				return fmt.Errorf("failed to load migration file: %w", err)
+			}
+
+			result, err := pkg.GetDeployment(mf.Migration.PulumiSources)
+			if err != nil {
+				return fmt.Errorf("failed to export Pulumi stack: %w", err)
+			}
+			var urns []string
+			for _, res := range result.Deployment.Resources {
+				urns = append(urns, string(res.URN))
+			}
+
+			reader := bufio.NewScanner(cmd.InOrStdin())
+			for i := range mf.Migration.Stacks {
+				stack := &mf.Migration.Stacks[i]
+
+				resolved, unresolved := migration.ResolveImportStubs(stack, urns)
+				if resolved > 0 {
+					fmt.Printf("Stack %s: resolved %d resources automatically\n", stack.PulumiStack, resolved)
+					if err := mf.Save(migrationFilePath); err != nil {
+						return fmt.Errorf("failed to save migration file: %w", err)
+					}
+				}
+
+				for _, stub := range unresolved {
+					done, err := promptForStub(reader, stack, stub)
+					if err != nil {
+						return err
+					}
+					if err := mf.Save(migrationFilePath); err != nil {
+						return fmt.Errorf("failed to save migration file: %w", err)
+					}
+					if done {
+						fmt.Println("Stopping; run the wizard again to continue.")
+						return nil
+					}
+				}
+			}
+
+			if blocker := findNextBlocker(mf); blocker == nil {
+				fmt.Println("All stacks are fully mapped. The migration is complete.")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&migrationFilePath, "migration", "m", "", "Path to the migration.json file")
+	cmd.MarkFlagRequired("migration")
+
+	return cmd
+}
+
+// promptForStub asks the user how to resolve one unresolved resource and applies the choice to
+// the stack. Returns true when the user asked to quit the wizard.
+func promptForStub(reader *bufio.Scanner, stack *migration.Stack, stub migration.UnresolvedStub) (bool, error) {
+	fmt.Printf("\n%s has no matching Pulumi resource.\n", stub.TFAddr)
+	for i, urn := range stub.PartialMatches {
+		fmt.Printf("  [%d] %s\n", i+1, urn)
+	}
+	fmt.Println("  [s] skip this resource")
+	fmt.Println("  [u] enter a URN manually")
+	fmt.Println("  [q] quit the wizard")
+
+	for {
+		fmt.Print("> ")
+		if !reader.Scan() {
+			return true, reader.Err()
+		}
+		choice := strings.TrimSpace(reader.Text())
+		switch {
+		case choice == "q":
+			return true, nil
+		case choice == "s":
+			setStackResource(stack, migration.Resource{TFAddr: stub.TFAddr, Migrate: migration.MigrateModeSkip})
+			return false, nil
+		case choice == "u":
+			fmt.Print("URN: ")
+			if !reader.Scan() {
+				return true, reader.Err()
+			}
+			urn := strings.TrimSpace(reader.Text())
+			if urn == "" {
+				fmt.Println("Empty URN; pick another option.")
+				continue
+			}
+			setStackResource(stack, migration.Resource{
+				TFAddr: stub.TFAddr,
+				URN:    urn,
+				Key:    migration.TerraformInstanceKey(stub.TFAddr),
+			})
+			return false, nil
+		default:
+			n, err := strconv.Atoi(choice)
+			if err != nil || n < 1 || n > len(stub.PartialMatches) {
+				fmt.Println("Invalid choice; pick a listed number, s, u or q.")
+				continue
+			}
+			setStackResource(stack, migration.Resource{
+				TFAddr: stub.TFAddr,
+				URN:    stub.PartialMatches[n-1],
+				Key:    migration.TerraformInstanceKey(stub.TFAddr),
+			})
+			return false, nil
+		}
+	}
+}
+
+// setStackResource updates the stack entry for the resource's Terraform address, adding one if
+// missing.
+func setStackResource(stack *migration.Stack, res migration.Resource) {
+	for i := range stack.Resources {
+		if stack.Resources[i].TFAddr == res.TFAddr {
+			stack.Resources[i] = res
+			return
+		}
+	}
+	stack.Resources = append(stack.Resources, res)
+}
+
+func init() {
+	rootCmd.AddCommand(newWizardCmd())
+}
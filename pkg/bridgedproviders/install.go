@@ -27,6 +27,11 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/common/workspace"
 )
 
+// envPluginDownloadURL allows users in air-gapped environments to redirect Pulumi plugin
+// downloads to an internal server. It is used when InstallProviderOptions.PluginDownloadURL is
+// not set; the explicit option always takes precedence over the environment variable.
+const envPluginDownloadURL = "PULUMI_TF_MIGRATE_PLUGIN_DOWNLOAD_URL"
+
 // InstallProviderOptions contains options for installing a provider.
 type InstallProviderOptions struct {
 	// Name is the provider name (e.g., "random", "aws", "azure-native")
@@ -34,6 +39,7 @@ type InstallProviderOptions struct {
 	// Version is the semver version string (e.g., "v4.18.4")
 	Version string
 	// PluginDownloadURL is an optional custom server URL to download the provider from.
+	// When empty, the PULUMI_TF_MIGRATE_PLUGIN_DOWNLOAD_URL environment variable is consulted.
 	PluginDownloadURL string
 }
 
@@ -78,6 +84,11 @@ func InstallProvider(ctx context.Context, opts InstallProviderOptions) (*Install
 		return nil, fmt.Errorf("failed to parse version %q: %w", opts.Version, err)
 	}
 
+	// Bound the download and install by --provider-timeout so a stalled plugin server fails
+	// fast instead of eating the whole command timeout.
+	ctx, cancel := withProviderTimeout(ctx)
+	defer cancel()
+
 	// Create a LocalWorkspace for plugin installation
 	w, err := auto.NewLocalWorkspace(ctx)
 	if err != nil {
@@ -85,13 +96,19 @@ func InstallProvider(ctx context.Context, opts InstallProviderOptions) (*Install
 	}
 
 	// Install the plugin using the automation API
-	if opts.PluginDownloadURL != "" {
-		err = w.InstallPluginFromServer(ctx, opts.Name, opts.Version, opts.PluginDownloadURL)
+	downloadURL := opts.PluginDownloadURL
+	if downloadURL == "" {
+		downloadURL = os.Getenv(envPluginDownloadURL)
+	}
+	if downloadURL != "" {
+		err = w.InstallPluginFromServer(ctx, opts.Name, opts.Version, downloadURL)
 	} else {
 		err = w.InstallPlugin(ctx, opts.Name, opts.Version)
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to install provider %s: %w", opts.Name, err)
+		return nil, providerTimeoutError(ctx,
+			fmt.Sprintf("installing provider %s v%s", opts.Name, ver),
+			fmt.Errorf("failed to install provider %s: %w", opts.Name, err))
 	}
 
 	// Get the plugin directory
@@ -44,8 +44,15 @@ type GetMappingResult struct {
 	Provider string
 	// Data is the mapping data in a format specific to the conversion plugin/source language
 	Data []byte
+	// Key is the conversion key the mapping was found under. This may differ from the requested
+	// key when a fallback key succeeded.
+	Key string
 }
 
+// mappingKeyFallbacks are tried in order when the requested conversion key yields no mapping.
+// Some bridged provider binaries expose the Terraform mapping under "tf" instead of "terraform".
+var mappingKeyFallbacks = []string{"terraform", "tf"}
+
 // GetMappingFromBinary initializes a Pulumi provider binary at the given path
 // and calls GetMapping with the specified options. The provider must implement
 // the ResourceProvider gRPC service defined in provider.proto.
@@ -66,6 +73,11 @@ func GetMappingFromBinary(ctx context.Context, binaryPath string, opts GetMappin
 		return nil, fmt.Errorf("Key is required in GetMappingOptions")
 	}
 
+	// Bound the schema fetch by --provider-timeout so a hanging provider binary fails fast
+	// instead of eating the whole command timeout.
+	ctx, cancel := withProviderTimeout(ctx)
+	defer cancel()
+
 	// Create a minimal host implementation for provider initialization.
 	host := &minimalHost{}
 
@@ -88,24 +100,39 @@ func GetMappingFromBinary(ctx context.Context, binaryPath string, opts GetMappin
 		defer contract.IgnoreError(provider.Close())
 	}()
 
-	// Call GetMapping on the provider
-	resp, err := provider.GetMapping(ctx, plugin.GetMappingRequest{
-		Key:      opts.Key,
-		Provider: opts.Provider,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("GetMapping failed: %w", err)
+	// Call GetMapping on the provider, falling back through the known key variants when the
+	// requested key yields no mapping.
+	keys := []string{opts.Key}
+	for _, fallback := range mappingKeyFallbacks {
+		if fallback != opts.Key {
+			keys = append(keys, fallback)
+		}
 	}
 
-	// If no provider was returned, the provider doesn't have a mapping for the given key
-	if resp.Provider == "" {
-		return nil, fmt.Errorf("provider at %s does not have a mapping for key %q", binaryPath, opts.Key)
+	for _, key := range keys {
+		resp, err := provider.GetMapping(ctx, plugin.GetMappingRequest{
+			Key:      key,
+			Provider: opts.Provider,
+		})
+		if err != nil {
+			return nil, providerTimeoutError(ctx,
+				fmt.Sprintf("fetching the mapping from %s", binaryPath),
+				fmt.Errorf("GetMapping failed: %w", err))
+		}
+
+		// An empty provider means the binary has no mapping under this key; try the next one.
+		if resp.Provider == "" {
+			continue
+		}
+
+		return &GetMappingResult{
+			Provider: resp.Provider,
+			Data:     resp.Data,
+			Key:      key,
+		}, nil
 	}
 
-	return &GetMappingResult{
-		Provider: resp.Provider,
-		Data:     resp.Data,
-	}, nil
+	return nil, fmt.Errorf("provider at %s does not have a mapping for any of the keys %q", binaryPath, keys)
 }
 
 // UnmarshalMappingData takes the result from GetMapping and unmarshals it into
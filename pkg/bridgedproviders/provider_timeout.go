@@ -0,0 +1,51 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridgedproviders
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// providerTimeout bounds individual provider operations (schema fetches, plugin downloads),
+// separately from the overall --timeout on the command. Zero, the default, means no limit.
+var providerTimeout time.Duration
+
+// SetProviderTimeout limits how long a single provider operation may take, as requested by the
+// --provider-timeout flag. A hanging provider binary or a stalled plugin download then fails fast
+// with a message naming the slow operation, instead of eating the whole command timeout. Pass 0 to
+// remove the limit.
+func SetProviderTimeout(d time.Duration) {
+	providerTimeout = d
+}
+
+// withProviderTimeout bounds a provider operation by the --provider-timeout deadline. With no
+// timeout configured the context is returned unchanged with a no-op cancel.
+func withProviderTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if providerTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, providerTimeout)
+}
+
+// providerTimeoutError rewrites a failure whose --provider-timeout deadline fired into a message
+// naming the slow operation; any other failure passes through unchanged.
+func providerTimeoutError(ctx context.Context, operation string, err error) error {
+	if providerTimeout > 0 && ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("%s did not finish within --provider-timeout=%v: %w", operation, providerTimeout, err)
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bridgedproviders
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Not parallel: mutates the package-level provider timeout setting.
+func Test_providerTimeout(t *testing.T) {
+	t.Run("no timeout leaves the context unchanged", func(t *testing.T) {
+		ctx := context.Background()
+		bounded, cancel := withProviderTimeout(ctx)
+		defer cancel()
+		_, hasDeadline := bounded.Deadline()
+		assert.False(t, hasDeadline)
+	})
+
+	t.Run("a deadline hit names the slow operation", func(t *testing.T) {
+		SetProviderTimeout(time.Nanosecond)
+		defer SetProviderTimeout(0)
+
+		ctx, cancel := withProviderTimeout(context.Background())
+		defer cancel()
+		<-ctx.Done()
+
+		err := providerTimeoutError(ctx, "fetching the mapping from pulumi-resource-aws", assert.AnError)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err,
+			"fetching the mapping from pulumi-resource-aws did not finish within --provider-timeout=1ns")
+	})
+
+	t.Run("other failures pass through unchanged", func(t *testing.T) {
+		SetProviderTimeout(time.Minute)
+		defer SetProviderTimeout(0)
+
+		ctx, cancel := withProviderTimeout(context.Background())
+		defer cancel()
+
+		err := providerTimeoutError(ctx, "installing provider aws v7.0.0", assert.AnError)
+		assert.Equal(t, assert.AnError, err)
+	})
+}
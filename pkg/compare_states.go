@@ -0,0 +1,169 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// CompareStatus classifies a Terraform resource when comparing states after migration.
+type CompareStatus string
+
+const (
+	// CompareStatusMatched indicates the Pulumi resource carries the same key attributes.
+	CompareStatusMatched CompareStatus = "matched"
+	// CompareStatusMissing indicates no corresponding Pulumi resource exists in the stack.
+	CompareStatusMissing CompareStatus = "missing"
+	// CompareStatusMismatched indicates a corresponding Pulumi resource exists but one or more key
+	// attributes disagree.
+	CompareStatusMismatched CompareStatus = "mismatched"
+)
+
+// AttributeMismatch details one attribute that disagrees between the Terraform state and the
+// imported Pulumi state.
+type AttributeMismatch struct {
+	// Terraform attribute name, such as "instance_type".
+	Attribute string `json:"attribute"`
+
+	TFValue     interface{} `json:"tf-value"`
+	PulumiValue interface{} `json:"pulumi-value"`
+}
+
+// StateComparison describes, for one Terraform resource, how faithfully the imported Pulumi state
+// reflects it.
+type StateComparison struct {
+	// Terraform resource address such as "aws_instance.web".
+	TFAddr string `json:"tf-addr"`
+
+	// URN of the corresponding Pulumi resource; empty while the resource is missing.
+	URN string `json:"urn,omitempty"`
+
+	Status CompareStatus `json:"status"`
+
+	Mismatches []AttributeMismatch `json:"mismatches,omitempty"`
+}
+
+// CompareStates compares the resources in a Terraform state against a Pulumi stack of the project
+// in pulumiFolder, returning one entry per Terraform resource sorted by address. This is a
+// post-migration fidelity check: unlike the preview-based diff command it inspects the recorded
+// states directly, confirming per resource that the cloud provider ID and the scalar attributes
+// both states know about agree. An empty stackName compares against the currently selected stack.
+func CompareStates(ctx context.Context, tfState *tfjson.State, pulumiFolder, stackName string) ([]StateComparison, error) {
+	var result *DeploymentResult
+	var err error
+	if stackName == "" {
+		result, err = GetDeployment(pulumiFolder)
+	} else {
+		result, err = GetDeploymentForStack(pulumiFolder, stackName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment: %w", err)
+	}
+	return compareStatesAgainstDeployment(tfState, result.Deployment)
+}
+
+func compareStatesAgainstDeployment(
+	tfState *tfjson.State, deployment apitype.DeploymentV3,
+) ([]StateComparison, error) {
+	var comparisons []StateComparison
+	err := tofu.VisitResources(tfState, func(res *tfjson.StateResource) error {
+		comparison := StateComparison{TFAddr: res.Address, Status: CompareStatusMissing}
+		expectedName := PulumiNameFromTerraformAddress(res.Address, res.Type)
+		for _, pulumiRes := range deployment.Resources {
+			urnParts := strings.Split(string(pulumiRes.URN), "::")
+			if urnParts[len(urnParts)-1] != expectedName {
+				continue
+			}
+			comparison.URN = string(pulumiRes.URN)
+			comparison.Mismatches = compareResourceAttributes(res, pulumiRes)
+			if len(comparison.Mismatches) == 0 {
+				comparison.Status = CompareStatusMatched
+			} else {
+				comparison.Status = CompareStatusMismatched
+			}
+			break
+		}
+		comparisons = append(comparisons, comparison)
+		return nil
+	}, nil) // Use default options (skips data sources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to visit resources: %w", err)
+	}
+
+	sort.Slice(comparisons, func(i, j int) bool { return comparisons[i].TFAddr < comparisons[j].TFAddr })
+	return comparisons, nil
+}
+
+// compareResourceAttributes compares the key attributes of one Terraform resource against its
+// Pulumi counterpart. The cloud provider ID always participates; beyond that, scalar Terraform
+// attributes are compared against the Pulumi output found under the camel-cased attribute name.
+// Attributes absent from the Pulumi outputs are skipped rather than reported, since bridged
+// providers rename or restructure some properties and an absence is not evidence of drift.
+func compareResourceAttributes(tfRes *tfjson.StateResource, pulumiRes apitype.ResourceV3) []AttributeMismatch {
+	var mismatches []AttributeMismatch
+
+	tfID, _ := tfRes.AttributeValues["id"].(string)
+	pulumiID := string(pulumiRes.ID)
+	if tfID != "" && pulumiID != "" && tfID != pulumiID {
+		mismatches = append(mismatches, AttributeMismatch{
+			Attribute: "id", TFValue: tfID, PulumiValue: pulumiID,
+		})
+	}
+
+	for name, tfValue := range tfRes.AttributeValues {
+		if name == "id" || tfValue == nil {
+			continue
+		}
+		switch tfValue.(type) {
+		case string, bool, float64:
+			// Scalars compare meaningfully across the property renames the bridge applies.
+		default:
+			continue
+		}
+		pulumiValue, ok := pulumiRes.Outputs[tfAttributeToPulumiKey(name)]
+		if !ok || pulumiValue == nil {
+			continue
+		}
+		if !reflect.DeepEqual(tfValue, pulumiValue) {
+			mismatches = append(mismatches, AttributeMismatch{
+				Attribute: name, TFValue: tfValue, PulumiValue: pulumiValue,
+			})
+		}
+	}
+
+	sort.Slice(mismatches, func(i, j int) bool { return mismatches[i].Attribute < mismatches[j].Attribute })
+	return mismatches
+}
+
+// tfAttributeToPulumiKey converts a snake_case Terraform attribute name to the camelCase property
+// key the bridge derives by default, e.g. "instance_type" becomes "instanceType".
+func tfAttributeToPulumiKey(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] == "" {
+			continue
+		}
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
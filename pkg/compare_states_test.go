@@ -0,0 +1,132 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareStatesAgainstDeployment(t *testing.T) {
+	t.Parallel()
+
+	tfState := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{
+					{
+						Address:      "aws_instance.web",
+						Type:         "aws_instance",
+						Name:         "web",
+						ProviderName: "registry.terraform.io/hashicorp/aws",
+						AttributeValues: map[string]interface{}{
+							"id":            "i-123",
+							"instance_type": "t3.micro",
+							"tags":          map[string]interface{}{"env": "prod"},
+						},
+					},
+					{
+						Address:      "aws_instance.worker",
+						Type:         "aws_instance",
+						Name:         "worker",
+						ProviderName: "registry.terraform.io/hashicorp/aws",
+						AttributeValues: map[string]interface{}{
+							"id":            "i-456",
+							"instance_type": "t3.large",
+						},
+					},
+					{
+						Address:         "aws_s3_bucket.logs",
+						Type:            "aws_s3_bucket",
+						Name:            "logs",
+						ProviderName:    "registry.terraform.io/hashicorp/aws",
+						AttributeValues: map[string]interface{}{"id": "logs-bucket"},
+					},
+				},
+			},
+		},
+	}
+
+	deployment := apitype.DeploymentV3{
+		Resources: []apitype.ResourceV3{
+			{
+				URN: resource.URN("urn:pulumi:dev::proj::aws:ec2/instance:Instance::web"),
+				ID:  resource.ID("i-123"),
+				Outputs: map[string]interface{}{
+					"instanceType": "t3.micro",
+				},
+			},
+			{
+				URN: resource.URN("urn:pulumi:dev::proj::aws:ec2/instance:Instance::worker"),
+				ID:  resource.ID("i-456"),
+				Outputs: map[string]interface{}{
+					"instanceType": "t3.medium",
+				},
+			},
+		},
+	}
+
+	comparisons, err := compareStatesAgainstDeployment(tfState, deployment)
+	require.NoError(t, err)
+	require.Len(t, comparisons, 3)
+
+	require.Equal(t, "aws_instance.web", comparisons[0].TFAddr)
+	require.Equal(t, CompareStatusMatched, comparisons[0].Status)
+	require.Empty(t, comparisons[0].Mismatches)
+
+	require.Equal(t, "aws_instance.worker", comparisons[1].TFAddr)
+	require.Equal(t, CompareStatusMismatched, comparisons[1].Status)
+	require.Len(t, comparisons[1].Mismatches, 1)
+	require.Equal(t, "instance_type", comparisons[1].Mismatches[0].Attribute)
+	require.Equal(t, "t3.large", comparisons[1].Mismatches[0].TFValue)
+	require.Equal(t, "t3.medium", comparisons[1].Mismatches[0].PulumiValue)
+
+	require.Equal(t, "aws_s3_bucket.logs", comparisons[2].TFAddr)
+	require.Equal(t, CompareStatusMissing, comparisons[2].Status)
+	require.Empty(t, comparisons[2].URN)
+}
+
+func Test_compareResourceAttributes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("mismatched ids are reported", func(t *testing.T) {
+		t.Parallel()
+		mismatches := compareResourceAttributes(&tfjson.StateResource{
+			AttributeValues: map[string]interface{}{"id": "i-123"},
+		}, apitype.ResourceV3{ID: resource.ID("i-999")})
+		require.Len(t, mismatches, 1)
+		require.Equal(t, "id", mismatches[0].Attribute)
+	})
+
+	t.Run("attributes absent from the pulumi outputs are skipped", func(t *testing.T) {
+		t.Parallel()
+		mismatches := compareResourceAttributes(&tfjson.StateResource{
+			AttributeValues: map[string]interface{}{"id": "i-123", "availability_zone": "us-west-2a"},
+		}, apitype.ResourceV3{ID: resource.ID("i-123")})
+		require.Empty(t, mismatches)
+	})
+}
+
+func Test_tfAttributeToPulumiKey(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "instanceType", tfAttributeToPulumiKey("instance_type"))
+	require.Equal(t, "region", tfAttributeToPulumiKey("region"))
+	require.Equal(t, "enableDnsSupport", tfAttributeToPulumiKey("enable_dns_support"))
+}
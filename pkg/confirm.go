@@ -0,0 +1,74 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// confirmWrites is the process-wide setting controlled by the --confirm flag.
+var confirmWrites bool
+
+// SetConfirmWrites enables the interactive mode in which the translation prints a summary and
+// asks for confirmation before writing any output files. This mirrors Pulumi's own
+// confirm-before-mutate UX and prevents accidentally overwriting an existing output file.
+func SetConfirmWrites(v bool) {
+	confirmWrites = v
+}
+
+// assumeYes is the process-wide setting controlled by the --assume-yes flag (or CI=true in the
+// environment). It answers every confirmation prompt automatically so scripted migrations never
+// block on a hidden prompt.
+var assumeYes bool
+
+// SetAssumeYes makes every confirmation prompt proceed without asking, as requested by the
+// --assume-yes flag or a CI environment.
+func SetAssumeYes(v bool) {
+	assumeYes = v
+}
+
+// confirmWrite prints a summary of the translation result and asks on stdin whether to proceed
+// with writing to target. It returns true immediately when confirmation is not enabled or
+// --assume-yes answers it. The summary and prompt go to stderr so stdout stays pure JSON when
+// writing state to stdout.
+func confirmWrite(res *TranslateStateResult, target string) (bool, error) {
+	if !confirmWrites || assumeYes {
+		return true, nil
+	}
+
+	fmt.Fprintf(os.Stderr, "About to write %d resources to %s\n", len(res.Export.Deployment.Resources), target)
+	fmt.Fprintf(os.Stderr, "  required providers: %d\n", len(res.RequiredProviders))
+	fmt.Fprintf(os.Stderr, "  skipped resources:  %d\n", len(res.ErrorMessages))
+	if target != "-" {
+		if _, err := os.Stat(target); err == nil {
+			fmt.Fprintf(os.Stderr, "  %s already exists and will be overwritten\n", target)
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Proceed? [y/N]: ")
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false, fmt.Errorf("failed to read confirmation: %w", err)
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
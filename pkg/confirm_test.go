@@ -0,0 +1,41 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Not parallel: mutates the package-level confirmation settings.
+func Test_confirmWrite_assumeYes(t *testing.T) {
+	res := &TranslateStateResult{}
+
+	proceed, err := confirmWrite(res, "out.json")
+	require.NoError(t, err)
+	require.True(t, proceed, "writes proceed without a prompt when confirmation is off")
+
+	// With confirmation on, --assume-yes answers the prompt without touching stdin; were it
+	// consulted, reading stdin under `go test` would fail and fail the test.
+	SetConfirmWrites(true)
+	defer SetConfirmWrites(false)
+	SetAssumeYes(true)
+	defer SetAssumeYes(false)
+
+	proceed, err = confirmWrite(res, "out.json")
+	require.NoError(t, err)
+	require.True(t, proceed, "--assume-yes answers the confirmation prompt")
+}
@@ -0,0 +1,28 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+// continueOnProviderError is the process-wide setting controlled by the
+// --continue-on-provider-error flag.
+var continueOnProviderError bool
+
+// SetContinueOnProviderError controls how provider-level load failures are handled. By default a
+// statically bridged provider that fails to install or report its schema aborts the whole
+// translation; when enabled, all of that provider's resources are added to the skipped list with
+// the underlying error and translation continues with the remaining providers. This lets a mostly
+// bridged stack migrate even when one exotic provider is broken.
+func SetContinueOnProviderError(v bool) {
+	continueOnProviderError = v
+}
@@ -0,0 +1,77 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+)
+
+// ConvertSource translates the Terraform sources in tfDir into a Pulumi program in the given
+// language, writing the generated files to outDir. The heavy lifting is done by the converter
+// plugin behind `pulumi convert`, which must be available via the `pulumi` binary in PATH.
+func ConvertSource(ctx context.Context, tfDir, language, outDir string) error {
+	absOut, err := filepath.Abs(outDir)
+	if err != nil {
+		return fmt.Errorf("resolving output directory: %w", err)
+	}
+	cmd := exec.CommandContext(ctx, "pulumi", "convert",
+		"--from", "terraform", "--language", language, "--out", absOut)
+	cmd.Dir = tfDir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("pulumi convert failed: %w", err)
+	}
+	return nil
+}
+
+// PrepopulateMigrationURNs fills in missing URNs in a migration file by translating the Terraform
+// state and predicting the URN each resource will have in the converted Pulumi program. This
+// closes the loop between source and state migration: resources the program will declare are
+// mapped before the stacks even exist.
+func PrepopulateMigrationURNs(
+	ctx context.Context, mf *migration.MigrationFile, pulumiProgramDir string,
+) (int, error) {
+	projectName, err := ReadPulumiProjectName(pulumiProgramDir)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := translateProject(ctx, mf.Migration.TFSources, pulumiProgramDir, false, false)
+	if err != nil {
+		return 0, fmt.Errorf("failed to translate state: %w", err)
+	}
+
+	totalResolved := 0
+	for i := range mf.Migration.Stacks {
+		stack := &mf.Migration.Stacks[i]
+		var urns []string
+		for _, r := range res.Export.Deployment.Resources {
+			urnParts := strings.Split(string(r.URN), "::")
+			name := urnParts[len(urnParts)-1]
+			urns = append(urns, string(makeUrn(stack.PulumiStack, projectName, string(r.Type), name)))
+		}
+		resolved, _ := migration.ResolveImportStubs(stack, urns)
+		totalResolved += resolved
+	}
+	return totalResolved, nil
+}
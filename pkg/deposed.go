@@ -0,0 +1,35 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import "fmt"
+
+// includeDeposed is the process-wide setting controlled by the --include-deposed flag.
+var includeDeposed bool
+
+// SetIncludeDeposed controls how deposed resource instances, left behind by failed
+// create-before-destroy cycles, are handled during translation. By default they are ignored; when
+// enabled they are reported in the skipped list with their deposed key, so dangling infrastructure
+// is surfaced rather than silently dropped.
+func SetIncludeDeposed(v bool) {
+	includeDeposed = v
+}
+
+// deposedSkipMessage explains why a deposed instance was not translated.
+func deposedSkipMessage(deposedKey string) string {
+	return fmt.Sprintf(
+		"deposed object %q left behind by a failed create-before-destroy cycle; "+
+			"not translated, clean it up with `tofu apply` or `tofu state rm`", deposedKey)
+}
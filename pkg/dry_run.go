@@ -0,0 +1,45 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+)
+
+// dryRun is the process-wide setting controlled by the --dry-run flag.
+var dryRun bool
+
+// SetDryRun enables preview mode: the translation runs end to end and reports what would be
+// written — resource counts by type, required providers, skipped resources — but no output files
+// are touched. This lets users verify a translation before committing to it, without the
+// interactive prompt of --confirm.
+func SetDryRun(v bool) {
+	dryRun = v
+}
+
+// reportDryRun prints what the translation would have written and reports whether dry-run mode is
+// on; callers return without writing when it is.
+func reportDryRun(res *TranslateStateResult, target string) bool {
+	if !dryRun {
+		return false
+	}
+	fmt.Fprintf(os.Stderr, "Dry run: would write %d resources to %s\n",
+		len(res.Export.Deployment.Resources), target)
+	fmt.Fprintf(os.Stderr, "  required providers: %d\n", len(res.RequiredProviders))
+	fmt.Fprintf(os.Stderr, "  skipped resources:  %d\n", len(res.ErrorMessages))
+	fmt.Fprintln(os.Stderr, "Dry run; no files written.")
+	return true
+}
@@ -0,0 +1,67 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// deferProviderCredentials is the process-wide setting controlled by the --esc-provider-config
+// flag.
+var deferProviderCredentials bool
+
+// SetDeferProviderCredentials controls whether credential attributes found in Terraform provider
+// blocks are inlined into the synthesized pulumi:providers:* inputs. When on, credentials are
+// omitted so the imported providers resolve them from ambient configuration instead — typically a
+// Pulumi ESC environment or plain environment variables — while non-secret settings such as the
+// region are still carried over. Teams keeping credentials in ESC turn this on so secrets never
+// land in the stack file.
+func SetDeferProviderCredentials(defer_ bool) {
+	deferProviderCredentials = defer_
+}
+
+// providerCredentialKeys names provider-block attributes that carry credentials across the common
+// providers (AWS, Azure, GCP and friends). Matching is on the top-level attribute name.
+var providerCredentialKeys = map[string]struct{}{
+	"access_key":    {},
+	"secret_key":    {},
+	"session_token": {},
+	"token":         {},
+	"password":      {},
+	"client_secret": {},
+	"credentials":   {},
+	"private_key":   {},
+	"api_key":       {},
+	"api_token":     {},
+}
+
+// filterProviderCredentials returns the provider config with credential attributes removed,
+// warning about each omission so the user knows to supply the value through ESC or the
+// environment.
+func filterProviderCredentials(providerName string, config resource.PropertyMap) resource.PropertyMap {
+	filtered := resource.PropertyMap{}
+	for key, value := range config {
+		if _, isCredential := providerCredentialKeys[string(key)]; isCredential {
+			fmt.Fprintf(os.Stderr, "Omitting provider %s credential attribute %s; "+
+				"supply it via a Pulumi ESC environment or environment variables\n", providerName, key)
+			continue
+		}
+		filtered[key] = value
+	}
+	return filtered
+}
@@ -0,0 +1,51 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/require"
+)
+
+// Not parallel: mutates the package-level defer-provider-credentials setting.
+func Test_GetProviderInputs_esc_provider_config(t *testing.T) {
+	config := resource.PropertyMap{
+		"region":     resource.NewStringProperty("us-west-2"),
+		"access_key": resource.NewStringProperty("AKIAEXAMPLE"),
+		"secret_key": resource.NewStringProperty("hunter2"),
+		"assume_role": resource.NewObjectProperty(resource.PropertyMap{
+			"role_arn": resource.NewStringProperty("arn:aws:iam::123456789012:role/migrate"),
+		}),
+	}
+
+	SetDeferProviderCredentials(true)
+	defer SetDeferProviderCredentials(false)
+
+	inputs, err := GetProviderInputs("aws", config)
+	require.NoError(t, err)
+	require.NotContains(t, inputs, resource.PropertyKey("access_key"),
+		"credentials must not be inlined when deferring to ESC")
+	require.NotContains(t, inputs, resource.PropertyKey("secret_key"),
+		"credentials must not be inlined when deferring to ESC")
+	require.Contains(t, inputs, resource.PropertyKey("region"))
+	require.Contains(t, inputs, resource.PropertyKey("assume_role"))
+
+	SetDeferProviderCredentials(false)
+	inputs, err = GetProviderInputs("aws", config)
+	require.NoError(t, err)
+	require.Contains(t, inputs, resource.PropertyKey("access_key"))
+}
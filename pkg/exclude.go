@@ -0,0 +1,77 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+)
+
+// excludeFilePath is the process-wide exclude file set by the --exclude-file flag.
+var excludeFilePath string
+
+// SetExcludeFile sets the path of a file listing Terraform resource addresses to exclude from
+// translation, one glob pattern per line. Lines starting with # and blank lines are ignored.
+// Excluded resources are reported in the skipped list rather than translated, which scales better
+// than repeated command-line filters for complex stacks and can be version-controlled alongside
+// migration.json.
+func SetExcludeFile(path string) {
+	excludeFilePath = path
+}
+
+// excludeList holds the tf-addr glob patterns parsed from the exclude file.
+type excludeList struct {
+	patterns []string
+}
+
+// loadExcludeList parses the configured exclude file. Returns an empty list when no file is set.
+func loadExcludeList() (*excludeList, error) {
+	list := &excludeList{}
+	if excludeFilePath == "" {
+		return list, nil
+	}
+
+	data, err := os.ReadFile(excludeFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read exclude file: %w", err)
+	}
+
+	for i, line := range strings.Split(string(data), "\n") {
+		pattern := strings.TrimSpace(line)
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern on line %d of %s: %q", i+1, excludeFilePath, pattern)
+		}
+		list.patterns = append(list.patterns, pattern)
+	}
+	return list, nil
+}
+
+// excludes reports whether a Terraform resource address matches any of the patterns.
+func (l *excludeList) excludes(addr string) bool {
+	for _, pattern := range l.patterns {
+		if pattern == addr {
+			return true
+		}
+		if matched, _ := path.Match(pattern, addr); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_excludeList(t *testing.T) {
+	// Not parallel: mutates the process-wide exclude file path.
+	file := filepath.Join(t.TempDir(), "excludes.txt")
+	require.NoError(t, os.WriteFile(file, []byte(`
+# legacy networking, migrated separately
+module.network.*
+aws_instance.scratch
+`), 0o600))
+
+	SetExcludeFile(file)
+	t.Cleanup(func() { SetExcludeFile("") })
+
+	list, err := loadExcludeList()
+	require.NoError(t, err)
+
+	assert.True(t, list.excludes("aws_instance.scratch"))
+	assert.True(t, list.excludes("module.network.aws_vpc.main"))
+	assert.False(t, list.excludes("aws_instance.web"))
+}
+
+func Test_loadExcludeList_unset(t *testing.T) {
+	list, err := loadExcludeList()
+	require.NoError(t, err)
+	assert.False(t, list.excludes("aws_instance.web"))
+}
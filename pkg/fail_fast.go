@@ -0,0 +1,26 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+// failFast is the process-wide setting controlled by the --fail-fast flag.
+var failFast bool
+
+// SetFailFast controls how per-resource translation errors are handled. By default they
+// accumulate in the skipped list so an exploratory run reports every problem at once; when
+// enabled, translation stops at the first resource that cannot be translated, which suits strict
+// CI pipelines. Deliberate skips (excluded or deposed resources) are unaffected.
+func SetFailFast(v bool) {
+	failFast = v
+}
@@ -0,0 +1,102 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// ImportStatus classifies a Terraform resource in an import plan.
+type ImportStatus string
+
+const (
+	// ImportStatusMatched indicates a corresponding Pulumi resource already exists in the stack.
+	ImportStatusMatched ImportStatus = "matched"
+	// ImportStatusPending indicates no corresponding Pulumi resource exists yet and the resource
+	// remains to be imported.
+	ImportStatusPending ImportStatus = "pending"
+)
+
+// ImportDiff describes, for one Terraform resource, whether a matching Pulumi resource already
+// exists in the target stack and what remains to import.
+type ImportDiff struct {
+	// Terraform resource address such as "aws_instance.web".
+	TFAddr string `json:"tf-addr"`
+
+	// URN of the matched Pulumi resource; empty while the resource is pending import.
+	URN string `json:"urn,omitempty"`
+
+	Status ImportStatus `json:"status"`
+}
+
+// ComputeImportDiff compares the resources in a Terraform state against the Pulumi stack that is
+// currently selected in pulumiFolder, returning one entry per Terraform resource sorted by
+// address. Resources with a match in the stack carry the matched URN; the rest are pending.
+func ComputeImportDiff(ctx context.Context, tfState *tfjson.State, pulumiFolder string) ([]ImportDiff, error) {
+	result, err := GetDeployment(pulumiFolder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment: %w", err)
+	}
+	return computeImportDiffAgainstDeployment(tfState, result.Deployment)
+}
+
+func computeImportDiffAgainstDeployment(
+	tfState *tfjson.State, deployment apitype.DeploymentV3,
+) ([]ImportDiff, error) {
+	var diffs []ImportDiff
+	err := tofu.VisitResources(tfState, func(res *tfjson.StateResource) error {
+		diff := ImportDiff{TFAddr: res.Address, Status: ImportStatusPending}
+		for _, pulumiRes := range deployment.Resources {
+			if IsMatchingResource(res, pulumiRes) {
+				diff.URN = string(pulumiRes.URN)
+				diff.Status = ImportStatusMatched
+				break
+			}
+		}
+		diffs = append(diffs, diff)
+		return nil
+	}, nil) // Use default options (skips data sources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to visit resources: %w", err)
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].TFAddr < diffs[j].TFAddr })
+	return diffs, nil
+}
+
+// IsMatchingResource reports whether a deployed Pulumi resource corresponds to a Terraform
+// resource. The Pulumi resource name derived from the Terraform address must match the URN's
+// resource name, and when both sides know a cloud provider ID the IDs must agree as well.
+func IsMatchingResource(tfRes *tfjson.StateResource, pulumiRes apitype.ResourceV3) bool {
+	expectedName := PulumiNameFromTerraformAddress(tfRes.Address, tfRes.Type)
+	urnParts := strings.Split(string(pulumiRes.URN), "::")
+	if urnParts[len(urnParts)-1] != expectedName {
+		return false
+	}
+
+	tfID, _ := tfRes.AttributeValues["id"].(string)
+	pulumiID := string(pulumiRes.ID)
+	if tfID != "" && pulumiID != "" && tfID != pulumiID {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,113 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeImportDiffAgainstDeployment(t *testing.T) {
+	t.Parallel()
+
+	tfState := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{
+					{
+						Address:         "aws_instance.web",
+						Type:            "aws_instance",
+						Name:            "web",
+						ProviderName:    "registry.terraform.io/hashicorp/aws",
+						AttributeValues: map[string]interface{}{"id": "i-123"},
+					},
+					{
+						Address:         "aws_s3_bucket.logs",
+						Type:            "aws_s3_bucket",
+						Name:            "logs",
+						ProviderName:    "registry.terraform.io/hashicorp/aws",
+						AttributeValues: map[string]interface{}{"id": "logs-bucket"},
+					},
+				},
+			},
+		},
+	}
+
+	deployment := apitype.DeploymentV3{
+		Resources: []apitype.ResourceV3{
+			{
+				URN: resource.URN("urn:pulumi:dev::proj::aws:ec2/instance:Instance::web"),
+				ID:  resource.ID("i-123"),
+			},
+		},
+	}
+
+	diffs, err := computeImportDiffAgainstDeployment(tfState, deployment)
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+
+	require.Equal(t, "aws_instance.web", diffs[0].TFAddr)
+	require.Equal(t, ImportStatusMatched, diffs[0].Status)
+	require.Equal(t, "urn:pulumi:dev::proj::aws:ec2/instance:Instance::web", diffs[0].URN)
+
+	require.Equal(t, "aws_s3_bucket.logs", diffs[1].TFAddr)
+	require.Equal(t, ImportStatusPending, diffs[1].Status)
+	require.Empty(t, diffs[1].URN)
+}
+
+func TestIsMatchingResource(t *testing.T) {
+	t.Parallel()
+
+	tfRes := &tfjson.StateResource{
+		Address:         "aws_instance.web",
+		Type:            "aws_instance",
+		AttributeValues: map[string]interface{}{"id": "i-123"},
+	}
+
+	t.Run("matches on name and id", func(t *testing.T) {
+		t.Parallel()
+		require.True(t, IsMatchingResource(tfRes, apitype.ResourceV3{
+			URN: resource.URN("urn:pulumi:dev::proj::aws:ec2/instance:Instance::web"),
+			ID:  resource.ID("i-123"),
+		}))
+	})
+
+	t.Run("rejects mismatched id", func(t *testing.T) {
+		t.Parallel()
+		require.False(t, IsMatchingResource(tfRes, apitype.ResourceV3{
+			URN: resource.URN("urn:pulumi:dev::proj::aws:ec2/instance:Instance::web"),
+			ID:  resource.ID("i-999"),
+		}))
+	})
+
+	t.Run("rejects mismatched name", func(t *testing.T) {
+		t.Parallel()
+		require.False(t, IsMatchingResource(tfRes, apitype.ResourceV3{
+			URN: resource.URN("urn:pulumi:dev::proj::aws:ec2/instance:Instance::db"),
+			ID:  resource.ID("i-123"),
+		}))
+	})
+
+	t.Run("matches on name alone when an id is unknown", func(t *testing.T) {
+		t.Parallel()
+		require.True(t, IsMatchingResource(tfRes, apitype.ResourceV3{
+			URN: resource.URN("urn:pulumi:dev::proj::aws:ec2/instance:Instance::web"),
+		}))
+	})
+}
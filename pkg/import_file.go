@@ -0,0 +1,119 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// ImportFile is the JSON file format consumed by `pulumi import --file`.
+type ImportFile struct {
+	// NameTable maps symbolic names to URNs. Resources reference parents and explicit providers
+	// through these names rather than raw URNs; the import fails when a referenced name is
+	// missing.
+	NameTable map[string]resource.URN `json:"nameTable,omitempty"`
+
+	Resources []ImportResource `json:"resources"`
+}
+
+// ImportResource is one entry of an ImportFile.
+type ImportResource struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	ID   string `json:"id"`
+
+	// Provider optionally names a NameTable entry for the explicit provider serving this
+	// resource.
+	Provider string `json:"provider,omitempty"`
+
+	// Parent optionally names a NameTable entry for the resource's parent.
+	Parent string `json:"parent,omitempty"`
+}
+
+// UnresolvedImportIDs lists the resources in the file that have no import ID yet, rendered as
+// "name (type)". A file with unresolved IDs breaks `pulumi import --file`, so callers gate on an
+// empty result before handing the file over.
+func (f ImportFile) UnresolvedImportIDs() []string {
+	var unresolved []string
+	for _, res := range f.Resources {
+		if res.ID == "" {
+			unresolved = append(unresolved, fmt.Sprintf("%s (%s)", res.Name, res.Type))
+		}
+	}
+	return unresolved
+}
+
+// ExplicitProvider names a provider resource to target during import when the translation's
+// default provider link is not the right one, such as aliased multi-region providers.
+type ExplicitProvider struct {
+	// Name is the symbolic name entered in the name table.
+	Name string
+
+	// URN is the provider resource's URN in the target stack.
+	URN resource.URN
+}
+
+// BuildImportFile converts a translated Pulumi state into an import file for `pulumi import
+// --file`. Every resource references its provider by a symbolic name, and the NameTable is
+// populated with the provider URNs so cross-resource references resolve during import.
+// explicitProviders optionally overrides the provider per resource name, which multi-region
+// stacks need to target aliased providers; entries win over the state-derived provider link.
+func BuildImportFile(state *PulumiState, stackName, projectName string,
+	explicitProviders map[string]ExplicitProvider,
+) (ImportFile, error) {
+	file := ImportFile{NameTable: map[string]resource.URN{}}
+
+	claimName := func(name string, urn resource.URN) error {
+		if existing, ok := file.NameTable[name]; ok && existing != urn {
+			return fmt.Errorf("name table collision: %q refers to both %q and %q", name, existing, urn)
+		}
+		file.NameTable[name] = urn
+		return nil
+	}
+
+	for _, res := range state.Resources {
+		entry := ImportResource{
+			Type: res.Type,
+			Name: res.Name,
+			ID:   res.ID,
+		}
+
+		if explicit, ok := explicitProviders[res.Name]; ok {
+			if err := claimName(explicit.Name, explicit.URN); err != nil {
+				return ImportFile{}, err
+			}
+			entry.Provider = explicit.Name
+		} else if res.Provider != nil {
+			providerRecord, err := state.FindProvider(*res.Provider)
+			if err != nil {
+				return ImportFile{}, fmt.Errorf("resource %s: %w", res.Name, err)
+			}
+			urn := makeUrn(stackName, projectName, providerRecord.Type, providerRecord.Name)
+			if err := claimName(providerRecord.Name, urn); err != nil {
+				return ImportFile{}, err
+			}
+			entry.Provider = providerRecord.Name
+		}
+
+		file.Resources = append(file.Resources, entry)
+	}
+
+	if len(file.NameTable) == 0 {
+		file.NameTable = nil
+	}
+	return file, nil
+}
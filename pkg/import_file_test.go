@@ -0,0 +1,152 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BuildImportFile_explicit_provider(t *testing.T) {
+	t.Parallel()
+
+	awsProviderID := PulumiResourceID{
+		ID:   "a339fe8e-e15d-4203-8719-c0ca5d3f414e",
+		Type: "pulumi:providers:aws",
+		Name: "default_7_12_0",
+	}
+
+	file, err := BuildImportFile(&PulumiState{
+		Providers: []PulumiResource{
+			{PulumiResourceID: awsProviderID},
+		},
+		Resources: []PulumiResource{
+			{
+				PulumiResourceID: PulumiResourceID{
+					ID:   "my-bucket",
+					Type: "aws:s3/bucket:Bucket",
+					Name: "example",
+				},
+				Provider: &awsProviderID,
+			},
+		},
+	}, "dev", "example", nil)
+	require.NoError(t, err)
+
+	require.Len(t, file.Resources, 1)
+	res := file.Resources[0]
+	require.Equal(t, "aws:s3/bucket:Bucket", res.Type)
+	require.Equal(t, "my-bucket", res.ID)
+	require.Equal(t, "default_7_12_0", res.Provider)
+
+	// The provider reference resolves through the name table.
+	urn, ok := file.NameTable[res.Provider]
+	require.True(t, ok, "name table should contain the provider entry")
+	require.Equal(t,
+		resource.URN("urn:pulumi:dev::example::pulumi:providers:aws::default_7_12_0"), urn)
+}
+
+func Test_BuildImportFile_no_providers(t *testing.T) {
+	t.Parallel()
+
+	file, err := BuildImportFile(&PulumiState{
+		Resources: []PulumiResource{
+			{
+				PulumiResourceID: PulumiResourceID{
+					ID:   "r-123",
+					Type: "random:index/randomString:RandomString",
+					Name: "example",
+				},
+			},
+		},
+	}, "dev", "example", nil)
+	require.NoError(t, err)
+
+	require.Len(t, file.Resources, 1)
+	require.Empty(t, file.Resources[0].Provider)
+	require.Nil(t, file.NameTable)
+}
+
+func Test_BuildImportFile_multi_region_providers(t *testing.T) {
+	t.Parallel()
+
+	usEast := PulumiResourceID{
+		ID:   "e7a3dd2c-6bb4-44f5-9c39-6b9bfb7d9b40",
+		Type: "pulumi:providers:aws",
+		Name: "default_7_12_0",
+	}
+	usWest := PulumiResourceID{
+		ID:   "2b3c4d5e-9d3f-49c1-8f30-1f2a3b4c5d6e",
+		Type: "pulumi:providers:aws",
+		Name: "uswest2",
+	}
+
+	file, err := BuildImportFile(&PulumiState{
+		Providers: []PulumiResource{
+			{PulumiResourceID: usEast},
+			{PulumiResourceID: usWest},
+		},
+		Resources: []PulumiResource{
+			{
+				PulumiResourceID: PulumiResourceID{
+					ID:   "east-bucket",
+					Type: "aws:s3/bucket:Bucket",
+					Name: "east",
+				},
+				Provider: &usEast,
+			},
+			{
+				PulumiResourceID: PulumiResourceID{
+					ID:   "west-bucket",
+					Type: "aws:s3/bucket:Bucket",
+					Name: "west",
+				},
+				Provider: &usEast,
+			},
+		},
+	}, "dev", "example", map[string]ExplicitProvider{
+		// The west bucket must be imported through the aliased us-west-2 provider.
+		"west": {
+			Name: "uswest2",
+			URN:  "urn:pulumi:dev::example::pulumi:providers:aws::uswest2",
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, file.Resources, 2)
+	require.Equal(t, "default_7_12_0", file.Resources[0].Provider)
+	require.Equal(t, "uswest2", file.Resources[1].Provider)
+
+	require.Equal(t, resource.URN("urn:pulumi:dev::example::pulumi:providers:aws::default_7_12_0"),
+		file.NameTable["default_7_12_0"])
+	require.Equal(t, resource.URN("urn:pulumi:dev::example::pulumi:providers:aws::uswest2"),
+		file.NameTable["uswest2"])
+}
+
+func Test_UnresolvedImportIDs(t *testing.T) {
+	t.Parallel()
+
+	file := ImportFile{
+		Resources: []ImportResource{
+			{Type: "aws:s3/bucket:Bucket", Name: "resolved", ID: "bucket-id"},
+			{Type: "aws:ec2/instance:Instance", Name: "pending"},
+		},
+	}
+
+	require.Equal(t, []string{"pending (aws:ec2/instance:Instance)"}, file.UnresolvedImportIDs())
+	require.Empty(t, ImportFile{}.UnresolvedImportIDs())
+}
@@ -0,0 +1,131 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"sort"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// ImportIDStrategy proposes the cloud provider ID to use when importing a Terraform resource into
+// Pulumi. Implementations return ok=false when they have no opinion on a resource, letting the
+// next strategy (or the built-in heuristics) decide. This makes ID inference extensible for the
+// long tail of providers and unbridged resource types without patching this package.
+type ImportIDStrategy interface {
+	InferImportID(res *tfjson.StateResource) (id string, ok bool)
+}
+
+// ImportIDStrategyFunc adapts a plain function to the ImportIDStrategy interface.
+type ImportIDStrategyFunc func(res *tfjson.StateResource) (string, bool)
+
+func (f ImportIDStrategyFunc) InferImportID(res *tfjson.StateResource) (string, bool) {
+	return f(res)
+}
+
+// AttributeImportIDStrategy infers the import ID from a designated attribute per Terraform
+// resource type, e.g. {"google_compute_instance": "self_link"}. Resources whose type is not
+// listed, or whose attribute is absent or empty, are passed on to the next strategy.
+func AttributeImportIDStrategy(attrByType map[string]string) ImportIDStrategy {
+	return ImportIDStrategyFunc(func(res *tfjson.StateResource) (string, bool) {
+		attr, ok := attrByType[res.Type]
+		if !ok {
+			return "", false
+		}
+		id, _ := res.AttributeValues[attr].(string)
+		return id, id != ""
+	})
+}
+
+// ImportIDInferrer resolves import IDs for Terraform resources, consulting registered strategies
+// in registration order before falling back to the built-in heuristics.
+type ImportIDInferrer struct {
+	strategies []ImportIDStrategy
+	unresolved map[string]bool
+}
+
+func NewImportIDInferrer(strategies ...ImportIDStrategy) *ImportIDInferrer {
+	return &ImportIDInferrer{strategies: strategies}
+}
+
+// Register adds a strategy consulted after any previously registered ones.
+func (i *ImportIDInferrer) Register(strategy ImportIDStrategy) {
+	i.strategies = append(i.strategies, strategy)
+}
+
+// InferImportID returns the import ID for a resource, or an error when neither the registered
+// strategies nor the built-in heuristics can determine one.
+func (i *ImportIDInferrer) InferImportID(res *tfjson.StateResource) (string, error) {
+	for _, strategy := range i.strategies {
+		if id, ok := strategy.InferImportID(res); ok {
+			return id, nil
+		}
+	}
+
+	// Terraform's resource identity feature carries the canonical import identity; prefer it over
+	// the attribute-based heuristics when the provider populates it.
+	if id, ok := importIDFromIdentity(res); ok {
+		return id, nil
+	}
+
+	// Built-in heuristics: the "id" attribute almost always carries the provider ID; a few
+	// resource types expose it under a different well-known name instead.
+	for _, attr := range []string{"id", "arn", "self_link", "name"} {
+		if id, _ := res.AttributeValues[attr].(string); id != "" {
+			return id, nil
+		}
+	}
+	if i.unresolved == nil {
+		i.unresolved = map[string]bool{}
+	}
+	i.unresolved[res.Type] = true
+	return "", fmt.Errorf("cannot infer an import ID for %s: no strategy matched and the resource "+
+		"has no id, arn, self_link or name attribute", res.Address)
+}
+
+// importIDFromIdentity maps a resource's identity values to an import ID. Well-known identity
+// attributes are consulted first; an identity consisting of a single string value is used as-is.
+// Multi-attribute identities without a well-known key have no universal string form and are left
+// to the attribute-based heuristics.
+func importIDFromIdentity(res *tfjson.StateResource) (string, bool) {
+	if len(res.IdentityValues) == 0 {
+		return "", false
+	}
+	for _, attr := range []string{"id", "arn", "name"} {
+		if id, _ := res.IdentityValues[attr].(string); id != "" {
+			return id, true
+		}
+	}
+	if len(res.IdentityValues) == 1 {
+		for _, value := range res.IdentityValues {
+			if id, ok := value.(string); ok && id != "" {
+				return id, true
+			}
+		}
+	}
+	return "", false
+}
+
+// UnresolvedTypes returns the sorted Terraform resource types this inferrer failed to produce an
+// import ID for, so callers can tell users where manual IDs are needed.
+func (i *ImportIDInferrer) UnresolvedTypes() []string {
+	types := make([]string, 0, len(i.unresolved))
+	for t := range i.unresolved {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
@@ -0,0 +1,63 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// azureSegmentCasing canonicalizes the casing of well-known ARM resource ID path segments. The
+// Azure API is case-insensitive here but the Pulumi azure provider expects the canonical form, so
+// IDs recorded by Terraform with different casing would otherwise fail to import.
+var azureSegmentCasing = map[string]string{
+	"subscriptions":    "subscriptions",
+	"resourcegroups":   "resourceGroups",
+	"managementgroups": "managementGroups",
+	"providers":        "providers",
+}
+
+// AzureImportIDStrategy infers import IDs for azurerm resources. Azure import IDs are full ARM
+// resource IDs; the strategy takes the Terraform "id" attribute and normalizes the casing of its
+// well-known path segments. Resources without an ARM-shaped id are passed on, so the inferrer's
+// unresolved reporting shows where manual IDs are needed.
+func AzureImportIDStrategy() ImportIDStrategy {
+	return ImportIDStrategyFunc(func(res *tfjson.StateResource) (string, bool) {
+		if !strings.HasPrefix(res.Type, "azurerm_") {
+			return "", false
+		}
+		id, _ := res.AttributeValues["id"].(string)
+		if !strings.HasPrefix(id, "/") {
+			return "", false
+		}
+		return NormalizeAzureImportID(id), true
+	})
+}
+
+// NormalizeAzureImportID canonicalizes the casing of the key segments of an ARM resource ID, e.g.
+// /subscriptions/.../resourcegroups/rg becomes /subscriptions/.../resourceGroups/rg. Value
+// segments (names, subscription IDs) are preserved as-is.
+func NormalizeAzureImportID(id string) string {
+	segments := strings.Split(id, "/")
+	// ARM IDs alternate key/value segments after the leading slash: segments[1], [3], ... are
+	// keys such as "subscriptions" and "resourceGroups".
+	for i := 1; i < len(segments); i += 2 {
+		if canonical, ok := azureSegmentCasing[strings.ToLower(segments[i])]; ok {
+			segments[i] = canonical
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,102 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NormalizeAzureImportID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		"/subscriptions/0000/resourceGroups/my-rg",
+		NormalizeAzureImportID("/subscriptions/0000/resourcegroups/my-rg"))
+
+	// Value segments keep their casing, even when they collide with a key name.
+	assert.Equal(t,
+		"/subscriptions/0000/resourceGroups/ResourceGroups/providers/Microsoft.Network/virtualNetworks/vnet",
+		NormalizeAzureImportID("/subscriptions/0000/ResourceGroups/ResourceGroups/Providers/Microsoft.Network/virtualNetworks/vnet"))
+
+	// Already-canonical IDs pass through unchanged.
+	canonical := "/subscriptions/0000/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa"
+	assert.Equal(t, canonical, NormalizeAzureImportID(canonical))
+}
+
+func Test_AzureImportIDStrategy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("normalizes the id attribute for azurerm resources", func(t *testing.T) {
+		t.Parallel()
+		inferrer := NewImportIDInferrer(AzureImportIDStrategy())
+		id, err := inferrer.InferImportID(&tfjson.StateResource{
+			Address: "azurerm_resource_group.main",
+			Type:    "azurerm_resource_group",
+			AttributeValues: map[string]interface{}{
+				"id": "/subscriptions/0000/resourcegroups/my-rg",
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "/subscriptions/0000/resourceGroups/my-rg", id)
+	})
+
+	t.Run("ignores non-azure resources", func(t *testing.T) {
+		t.Parallel()
+		strategy := AzureImportIDStrategy()
+		_, ok := strategy.InferImportID(&tfjson.StateResource{
+			Address: "aws_instance.web",
+			Type:    "aws_instance",
+			AttributeValues: map[string]interface{}{
+				"id": "/not/an/arm/id",
+			},
+		})
+		assert.False(t, ok)
+	})
+
+	t.Run("passes on azure resources without an ARM-shaped id", func(t *testing.T) {
+		t.Parallel()
+		strategy := AzureImportIDStrategy()
+		_, ok := strategy.InferImportID(&tfjson.StateResource{
+			Address:         "azurerm_role_assignment.ra",
+			Type:            "azurerm_role_assignment",
+			AttributeValues: map[string]interface{}{},
+		})
+		assert.False(t, ok)
+	})
+}
+
+func Test_ImportIDInferrer_UnresolvedTypes(t *testing.T) {
+	t.Parallel()
+
+	inferrer := NewImportIDInferrer(AzureImportIDStrategy())
+	_, err := inferrer.InferImportID(&tfjson.StateResource{
+		Address:         "azurerm_role_assignment.ra",
+		Type:            "azurerm_role_assignment",
+		AttributeValues: map[string]interface{}{},
+	})
+	require.Error(t, err)
+	_, err = inferrer.InferImportID(&tfjson.StateResource{
+		Address:         "azurerm_role_assignment.rb",
+		Type:            "azurerm_role_assignment",
+		AttributeValues: map[string]interface{}{},
+	})
+	require.Error(t, err)
+
+	assert.Equal(t, []string{"azurerm_role_assignment"}, inferrer.UnresolvedTypes())
+}
@@ -0,0 +1,117 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportIDInferrer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("id attribute by default", func(t *testing.T) {
+		t.Parallel()
+		id, err := NewImportIDInferrer().InferImportID(&tfjson.StateResource{
+			Address:         "aws_instance.web",
+			Type:            "aws_instance",
+			AttributeValues: map[string]interface{}{"id": "i-123"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "i-123", id)
+	})
+
+	t.Run("registered strategy wins over built-in heuristics", func(t *testing.T) {
+		t.Parallel()
+		inferrer := NewImportIDInferrer()
+		inferrer.Register(AttributeImportIDStrategy(map[string]string{
+			"google_compute_instance": "self_link",
+		}))
+		id, err := inferrer.InferImportID(&tfjson.StateResource{
+			Address: "google_compute_instance.vm",
+			Type:    "google_compute_instance",
+			AttributeValues: map[string]interface{}{
+				"id":        "vm",
+				"self_link": "https://compute.googleapis.com/v1/projects/p/zones/z/instances/vm",
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "https://compute.googleapis.com/v1/projects/p/zones/z/instances/vm", id)
+	})
+
+	t.Run("strategy without an opinion falls through", func(t *testing.T) {
+		t.Parallel()
+		inferrer := NewImportIDInferrer(AttributeImportIDStrategy(map[string]string{
+			"google_compute_instance": "self_link",
+		}))
+		id, err := inferrer.InferImportID(&tfjson.StateResource{
+			Address:         "aws_instance.web",
+			Type:            "aws_instance",
+			AttributeValues: map[string]interface{}{"id": "i-123"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "i-123", id)
+	})
+
+	t.Run("identity values win over the id attribute", func(t *testing.T) {
+		t.Parallel()
+		id, err := NewImportIDInferrer().InferImportID(&tfjson.StateResource{
+			Address:         "aws_s3_bucket.example",
+			Type:            "aws_s3_bucket",
+			AttributeValues: map[string]interface{}{"id": "legacy-id"},
+			IdentityValues:  map[string]interface{}{"arn": "arn:aws:s3:::example"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "arn:aws:s3:::example", id)
+	})
+
+	t.Run("single-attribute identity is used under any key", func(t *testing.T) {
+		t.Parallel()
+		id, err := NewImportIDInferrer().InferImportID(&tfjson.StateResource{
+			Address:        "aws_s3_bucket.single",
+			Type:           "aws_s3_bucket",
+			IdentityValues: map[string]interface{}{"bucket": "my-bucket"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "my-bucket", id)
+	})
+
+	t.Run("multi-attribute identity falls back to heuristics", func(t *testing.T) {
+		t.Parallel()
+		id, err := NewImportIDInferrer().InferImportID(&tfjson.StateResource{
+			Address:         "aws_route.example",
+			Type:            "aws_route",
+			AttributeValues: map[string]interface{}{"id": "r-123"},
+			IdentityValues: map[string]interface{}{
+				"route_table_id":         "rtb-1",
+				"destination_cidr_block": "10.0.0.0/16",
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "r-123", id)
+	})
+
+	t.Run("errors when nothing matches", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewImportIDInferrer().InferImportID(&tfjson.StateResource{
+			Address: "custom_thing.x",
+			Type:    "custom_thing",
+		})
+		require.ErrorContains(t, err, "cannot infer an import ID for custom_thing.x")
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// envInputDenyFile optionally points at a JSON file extending the input deny list, mapping Pulumi
+// type tokens to arrays of property paths, e.g. {"aws:iam/rolePolicy:RolePolicy": ["policy"]}.
+const envInputDenyFile = "PULUMI_TF_MIGRATE_INPUT_DENY_FILE"
+
+// InputDenyList maps a Pulumi type token to property paths that are excluded from the inputs
+// synthesized for resources of that type. Some Terraform attributes — timestamps and computed
+// values the provider recomputes or normalizes on every operation — cause spurious diffs right
+// after import; denying them keeps the first post-migration preview clean. Paths address nested
+// object properties with dots, e.g. "rule.filter"; a path segment applies to every element of an
+// array value.
+type InputDenyList map[string][]string
+
+// defaultInputDenyList covers cases known to produce immediate post-import diffs.
+var defaultInputDenyList = InputDenyList{
+	// The provider normalizes the policy document on read, so the raw imported JSON string
+	// differs byte-for-byte from what the provider would write back.
+	"aws:iam/rolePolicy:RolePolicy": {"policy"},
+
+	// Recomputed by the provider on every apply.
+	"aws:s3/bucketLifecycleConfigurationV2:BucketLifecycleConfigurationV2": {
+		"transitionDefaultMinimumObjectSize",
+	},
+}
+
+// loadInputDenyList resolves the effective deny list once per process.
+var loadInputDenyList = sync.OnceValues(effectiveInputDenyList)
+
+// effectiveInputDenyList returns the default deny list merged with user-supplied entries from the
+// JSON file referenced by PULUMI_TF_MIGRATE_INPUT_DENY_FILE, if set. User paths for a type are
+// appended to the defaults.
+func effectiveInputDenyList() (InputDenyList, error) {
+	merged := InputDenyList{}
+	for pulumiType, paths := range defaultInputDenyList {
+		merged[pulumiType] = append([]string{}, paths...)
+	}
+
+	denyFile := os.Getenv(envInputDenyFile)
+	if denyFile == "" {
+		return merged, nil
+	}
+
+	bytes, err := os.ReadFile(denyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", envInputDenyFile, err)
+	}
+	var user InputDenyList
+	if err := json.Unmarshal(bytes, &user); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", envInputDenyFile, err)
+	}
+	for pulumiType, paths := range user {
+		merged[pulumiType] = append(merged[pulumiType], paths...)
+	}
+	return merged, nil
+}
+
+// filterInputs removes the denied property paths for the given Pulumi type from the inputs map in
+// place and returns it.
+func (d InputDenyList) filterInputs(pulumiType string, inputs resource.PropertyMap) resource.PropertyMap {
+	for _, path := range d[pulumiType] {
+		removePropertyPath(inputs, strings.Split(path, "."))
+	}
+	return inputs
+}
+
+// removePropertyPath removes the property addressed by the path segments from the map, recursing
+// through nested objects and applying to every element of arrays along the way.
+func removePropertyPath(props resource.PropertyMap, path []string) {
+	if len(path) == 0 {
+		return
+	}
+	key := resource.PropertyKey(path[0])
+	if len(path) == 1 {
+		delete(props, key)
+		return
+	}
+
+	value, ok := props[key]
+	if !ok {
+		return
+	}
+	removePropertyPathFromValue(value, path[1:])
+}
+
+func removePropertyPathFromValue(value resource.PropertyValue, path []string) {
+	switch {
+	case value.IsObject():
+		removePropertyPath(value.ObjectValue(), path)
+	case value.IsArray():
+		for _, element := range value.ArrayValue() {
+			removePropertyPathFromValue(element, path)
+		}
+	case value.IsSecret():
+		removePropertyPathFromValue(value.SecretValue().Element, path)
+	}
+}
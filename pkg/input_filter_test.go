@@ -0,0 +1,86 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInputDenyList_filterInputs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("removes denied top-level properties", func(t *testing.T) {
+		t.Parallel()
+
+		inputs := resource.PropertyMap{
+			"policy": resource.NewStringProperty(`{"Version":"2012-10-17"}`),
+			"role":   resource.NewStringProperty("my-role"),
+		}
+
+		filtered := defaultInputDenyList.filterInputs("aws:iam/rolePolicy:RolePolicy", inputs)
+		_, hasPolicy := filtered["policy"]
+		require.False(t, hasPolicy)
+		require.Equal(t, "my-role", filtered["role"].StringValue())
+	})
+
+	t.Run("recurses into nested objects and arrays", func(t *testing.T) {
+		t.Parallel()
+
+		denyList := InputDenyList{"test:index:Type": {"rules.filter"}}
+		inputs := resource.PropertyMap{
+			"rules": resource.NewArrayProperty([]resource.PropertyValue{
+				resource.NewObjectProperty(resource.PropertyMap{
+					"id":     resource.NewStringProperty("rule-1"),
+					"filter": resource.NewObjectProperty(resource.PropertyMap{}),
+				}),
+			}),
+		}
+
+		filtered := denyList.filterInputs("test:index:Type", inputs)
+		rule := filtered["rules"].ArrayValue()[0].ObjectValue()
+		_, hasFilter := rule["filter"]
+		require.False(t, hasFilter)
+		require.Equal(t, "rule-1", rule["id"].StringValue())
+	})
+
+	t.Run("leaves other types untouched", func(t *testing.T) {
+		t.Parallel()
+
+		inputs := resource.PropertyMap{
+			"policy": resource.NewStringProperty("{}"),
+		}
+		filtered := defaultInputDenyList.filterInputs("aws:iam/policy:Policy", inputs)
+		require.Equal(t, "{}", filtered["policy"].StringValue())
+	})
+}
+
+func Test_effectiveInputDenyList(t *testing.T) {
+	denyFile := filepath.Join(t.TempDir(), "deny.json")
+	require.NoError(t, os.WriteFile(denyFile,
+		[]byte(`{"aws:iam/rolePolicy:RolePolicy":["namePrefix"],"custom:index:Widget":["etag"]}`), 0o600))
+	t.Setenv(envInputDenyFile, denyFile)
+
+	denyList, err := effectiveInputDenyList()
+	require.NoError(t, err)
+
+	// User entries extend the defaults rather than replacing them.
+	require.ElementsMatch(t, []string{"policy", "namePrefix"}, denyList["aws:iam/rolePolicy:RolePolicy"])
+	require.Equal(t, []string{"etag"}, denyList["custom:index:Widget"])
+}
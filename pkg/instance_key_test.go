@@ -0,0 +1,39 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PulumiNameWithInstanceKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		address      string
+		resourceType string
+		expected     string
+	}{
+		{`aws_instance.web["a"]`, "aws_instance", "web-a"},
+		{`aws_instance.web[0]`, "aws_instance", "web-0"},
+		{`module.app.aws_instance.web["us-east-1"]`, "aws_instance", "app_web-us-east-1"},
+		{`aws_instance.web`, "aws_instance", ""},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, PulumiNameWithInstanceKey(tc.address, tc.resourceType), tc.address)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// envJSONDocumentAttrsFile optionally points at a JSON file extending the per-provider set of
+// JSON-document attributes, mapping provider local names to arrays of attribute names, e.g.
+// {"aws": ["container_definitions"]}.
+const envJSONDocumentAttrsFile = "PULUMI_TF_MIGRATE_JSON_ATTRS_FILE"
+
+// defaultJSONDocumentAttributes lists Terraform attributes, per provider local name, whose values
+// are JSON documents serialized as strings. Terraform and the Pulumi provider serialize these
+// documents with different key ordering, which causes no-op diffs after migration; canonicalizing
+// the JSON makes a post-import preview show `same`.
+var defaultJSONDocumentAttributes = map[string][]string{
+	"aws": {"policy", "assume_role_policy"},
+}
+
+// loadJSONDocumentAttributes resolves the effective attribute sets once per process.
+var loadJSONDocumentAttributes = sync.OnceValues(effectiveJSONDocumentAttributes)
+
+// effectiveJSONDocumentAttributes returns the default per-provider attribute sets merged with
+// user-supplied entries from the JSON file referenced by PULUMI_TF_MIGRATE_JSON_ATTRS_FILE.
+func effectiveJSONDocumentAttributes() (map[string]map[string]bool, error) {
+	merged := map[string]map[string]bool{}
+	add := func(provider string, attrs []string) {
+		if merged[provider] == nil {
+			merged[provider] = map[string]bool{}
+		}
+		for _, attr := range attrs {
+			merged[provider][attr] = true
+		}
+	}
+	for provider, attrs := range defaultJSONDocumentAttributes {
+		add(provider, attrs)
+	}
+
+	attrsFile := os.Getenv(envJSONDocumentAttrsFile)
+	if attrsFile == "" {
+		return merged, nil
+	}
+	bytes, err := os.ReadFile(attrsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", envJSONDocumentAttrsFile, err)
+	}
+	var user map[string][]string
+	if err := json.Unmarshal(bytes, &user); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", envJSONDocumentAttrsFile, err)
+	}
+	for provider, attrs := range user {
+		add(provider, attrs)
+	}
+	return merged, nil
+}
+
+// normalizeJSONDocuments canonicalizes the JSON-document attributes of a resource's cty value so
+// key ordering differences between Terraform and the Pulumi provider don't surface as diffs.
+// Attributes that don't hold a JSON object or array are left untouched.
+func normalizeJSONDocuments(value cty.Value, attrs map[string]bool) cty.Value {
+	if len(attrs) == 0 || !value.Type().IsObjectType() || value.IsNull() {
+		return value
+	}
+
+	valueMap := value.AsValueMap()
+	changed := false
+	for name, attr := range valueMap {
+		if !attrs[name] || attr.Type() != cty.String || attr.IsNull() || !attr.IsKnown() {
+			continue
+		}
+		canonical, ok := canonicalizeJSON(attr.AsString())
+		if ok && canonical != attr.AsString() {
+			valueMap[name] = cty.StringVal(canonical)
+			changed = true
+		}
+	}
+	if !changed {
+		return value
+	}
+	return cty.ObjectVal(valueMap)
+}
+
+// canonicalizeJSON re-serializes a JSON object or array with sorted keys and no insignificant
+// whitespace. Returns ok=false for values that are not JSON documents.
+func canonicalizeJSON(document string) (string, bool) {
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(document), &parsed); err != nil {
+		return "", false
+	}
+	switch parsed.(type) {
+	case map[string]interface{}, []interface{}:
+	default:
+		// A bare string or number is not a document; leave it alone.
+		return "", false
+	}
+	canonical, err := json.Marshal(parsed)
+	if err != nil {
+		return "", false
+	}
+	return string(canonical), true
+}
@@ -0,0 +1,87 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// goldenPolicy is the canonical serialization of the IAM policy used in the tests below: keys
+// sorted, no insignificant whitespace.
+const goldenPolicy = `{"Statement":[{"Action":"s3:GetObject","Effect":"Allow",` +
+	`"Resource":"arn:aws:s3:::my-bucket/*"}],"Version":"2012-10-17"}`
+
+func Test_normalizeJSONDocuments(t *testing.T) {
+	t.Parallel()
+
+	attrs := map[string]bool{"policy": true, "assume_role_policy": true}
+
+	t.Run("differently ordered documents normalize to the same golden form", func(t *testing.T) {
+		t.Parallel()
+
+		// Terraform and the Pulumi provider serialize the same document differently; both
+		// orderings must canonicalize identically so a post-import preview shows `same`.
+		terraformOrder := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow",` +
+			`"Action":"s3:GetObject","Resource":"arn:aws:s3:::my-bucket/*"}]}`
+		providerOrder := `{"Statement":[{"Resource":"arn:aws:s3:::my-bucket/*",` +
+			`"Action":"s3:GetObject","Effect":"Allow"}],"Version":"2012-10-17"}`
+
+		for _, doc := range []string{terraformOrder, providerOrder} {
+			normalized := normalizeJSONDocuments(cty.ObjectVal(map[string]cty.Value{
+				"policy": cty.StringVal(doc),
+			}), attrs)
+			assert.Equal(t, goldenPolicy, normalized.GetAttr("policy").AsString())
+		}
+	})
+
+	t.Run("non-document and unlisted attributes are untouched", func(t *testing.T) {
+		t.Parallel()
+
+		value := cty.ObjectVal(map[string]cty.Value{
+			"policy":      cty.StringVal("not json"),
+			"name":        cty.StringVal(`{"b":1,"a":2}`),
+			"description": cty.NullVal(cty.String),
+		})
+		assert.True(t, value.RawEquals(normalizeJSONDocuments(value, attrs)))
+	})
+}
+
+func Test_effectiveJSONDocumentAttributes(t *testing.T) {
+	t.Run("defaults cover aws policy documents", func(t *testing.T) {
+		attrs, err := effectiveJSONDocumentAttributes()
+		require.NoError(t, err)
+		assert.True(t, attrs["aws"]["policy"])
+		assert.True(t, attrs["aws"]["assume_role_policy"])
+	})
+
+	t.Run("env file extends the defaults per provider", func(t *testing.T) {
+		attrsFile := filepath.Join(t.TempDir(), "json-attrs.json")
+		require.NoError(t, os.WriteFile(attrsFile,
+			[]byte(`{"aws": ["container_definitions"], "custom": ["spec"]}`), 0o600))
+		t.Setenv(envJSONDocumentAttrsFile, attrsFile)
+
+		attrs, err := effectiveJSONDocumentAttributes()
+		require.NoError(t, err)
+		assert.True(t, attrs["aws"]["policy"])
+		assert.True(t, attrs["aws"]["container_definitions"])
+		assert.True(t, attrs["custom"]["spec"])
+	})
+}
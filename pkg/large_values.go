@@ -0,0 +1,48 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// maxInlineInputSize is the size above which a string input is dropped from a resource's inputs.
+// Resources like aws_lambda_function with an inline zip or aws_s3_object with inline content carry
+// multi-megabyte base64 attributes; replaying them as inputs is rarely useful (the content already
+// lives in the cloud resource) and causes noisy preview diffs, while keeping them inflates the
+// translated state file. The value stays in the outputs, which record the observed state.
+const maxInlineInputSize = 256 * 1024
+
+// DropOversizedInputs removes top-level string inputs larger than maxInlineInputSize, warning on
+// stderr for each dropped attribute. Secret-wrapped strings are measured through the wrapper.
+func DropOversizedInputs(inputs resource.PropertyMap, address string) resource.PropertyMap {
+	for key, value := range inputs {
+		unwrapped := value
+		if unwrapped.IsSecret() {
+			unwrapped = unwrapped.SecretValue().Element
+		}
+		if !unwrapped.IsString() || len(unwrapped.StringValue()) <= maxInlineInputSize {
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "Warning: dropping input %s of %s from the translated state: "+
+			"its %d-byte inline value exceeds %d bytes; the value is preserved in the resource outputs\n",
+			key, address, len(unwrapped.StringValue()), maxInlineInputSize)
+		delete(inputs, key)
+	}
+	return inputs
+}
@@ -0,0 +1,58 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_DropOversizedInputs(t *testing.T) {
+	t.Parallel()
+
+	huge := strings.Repeat("a", maxInlineInputSize+1)
+
+	t.Run("drops oversized strings", func(t *testing.T) {
+		t.Parallel()
+		inputs := resource.PropertyMap{
+			"content": resource.NewStringProperty(huge),
+			"bucket":  resource.NewStringProperty("my-bucket"),
+		}
+		inputs = DropOversizedInputs(inputs, "aws_s3_object.big")
+		assert.NotContains(t, inputs, resource.PropertyKey("content"))
+		assert.Contains(t, inputs, resource.PropertyKey("bucket"))
+	})
+
+	t.Run("drops oversized secret strings", func(t *testing.T) {
+		t.Parallel()
+		inputs := resource.PropertyMap{
+			"content": resource.MakeSecret(resource.NewStringProperty(huge)),
+		}
+		inputs = DropOversizedInputs(inputs, "aws_s3_object.big")
+		assert.Empty(t, inputs)
+	})
+
+	t.Run("keeps strings at the threshold", func(t *testing.T) {
+		t.Parallel()
+		inputs := resource.PropertyMap{
+			"content": resource.NewStringProperty(strings.Repeat("a", maxInlineInputSize)),
+		}
+		inputs = DropOversizedInputs(inputs, "aws_s3_object.ok")
+		assert.Contains(t, inputs, resource.PropertyKey("content"))
+	})
+}
@@ -20,7 +20,6 @@ import (
 	"os"
 	"strings"
 
-	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
 )
 
@@ -69,9 +68,7 @@ func CheckMigrationIntegrity(ctx context.Context, migrationFile *MigrationFile)
 	checkUniqueMapping(migrationFile, result)
 
 	// Check 3: Verify resources match Terraform state
-	if err := checkStateConsistency(ctx, migrationFile, result); err != nil {
-		return nil, err
-	}
+	checkStateConsistency(ctx, migrationFile, result)
 
 	return result, nil
 }
@@ -112,6 +109,11 @@ func checkUniqueMapping(mf *MigrationFile, result *CheckResult) {
 		urnToTFAddr := make(map[string][]string)
 
 		for j, res := range stack.Resources {
+			// Pulumi-native resources have no Terraform counterpart and need no import.
+			if res.Migrate == MigrateModeNew {
+				continue
+			}
+
 			// Validate that resources have both tf-addr and URN
 			if res.TFAddr == "" {
 				result.AddErrorWithSuggestion("invalid-resource",
@@ -160,8 +162,10 @@ func checkUniqueMapping(mf *MigrationFile, result *CheckResult) {
 	}
 }
 
-// checkStateConsistency verifies that resources in migration.json match the Terraform state
-func checkStateConsistency(ctx context.Context, mf *MigrationFile, result *CheckResult) error {
+// checkStateConsistency verifies that resources in migration.json match the Terraform state.
+// State-load failures are recorded as errors rather than aborting, so a broken state file in one
+// stack does not hide problems found by the other checks.
+func checkStateConsistency(ctx context.Context, mf *MigrationFile, result *CheckResult) {
 	for i, stack := range mf.Migration.Stacks {
 		stackPrefix := fmt.Sprintf("stack[%d] (%s)", i, stack.PulumiStack)
 
@@ -170,28 +174,26 @@ func checkStateConsistency(ctx context.Context, mf *MigrationFile, result *Check
 			continue
 		}
 
-		// Load the Terraform state
-		state, err := tofu.LoadTerraformState(ctx, tofu.LoadTerraformStateOptions{
+		// Only addresses are needed here, so the lightweight enumeration path avoids loading
+		// provider schemas through `tofu show`.
+		addrs, err := tofu.LoadStateAddresses(ctx, tofu.LoadTerraformStateOptions{
 			StateFilePath: stack.TFState,
 		})
 		if err != nil {
-			return fmt.Errorf("failed to load state for %s: %w", stackPrefix, err)
+			result.AddErrorWithSuggestion("state-load",
+				fmt.Sprintf("failed to load state for %s: %v", stackPrefix, err),
+				"Fix or remove the tf-state entry for this stack; its state-consistency check was skipped")
+			continue
 		}
-
-		// Collect all resource addresses from the state
 		stateAddrs := make(map[string]bool)
-		err = tofu.VisitResources(state, func(res *tfjson.StateResource) error {
-			stateAddrs[res.Address] = true
-			return nil
-		}, nil) // Use default options (skips data sources)
-		if err != nil {
-			return fmt.Errorf("failed to visit resources in state for %s: %w", stackPrefix, err)
+		for _, addr := range addrs {
+			stateAddrs[addr] = true
 		}
 
 		// Collect all tf-addrs from migration.json for this stack
 		migrationAddrs := make(map[string]bool)
 		for _, res := range stack.Resources {
-			if res.TFAddr != "" {
+			if res.TFAddr != "" && res.Migrate != MigrateModeNew {
 				migrationAddrs[res.TFAddr] = true
 			}
 		}
@@ -216,6 +218,4 @@ func checkStateConsistency(ctx context.Context, mf *MigrationFile, result *Check
 			}
 		}
 	}
-
-	return nil
 }
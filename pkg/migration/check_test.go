@@ -537,9 +537,8 @@ func TestCheckStateConsistency(t *testing.T) {
 		}
 
 		result := &CheckResult{}
-		err := checkStateConsistency(ctx, mf, result)
+		checkStateConsistency(ctx, mf, result)
 
-		require.NoError(t, err)
 		assert.False(t, result.HasErrors())
 	})
 
@@ -564,9 +563,8 @@ func TestCheckStateConsistency(t *testing.T) {
 		}
 
 		result := &CheckResult{}
-		err := checkStateConsistency(ctx, mf, result)
+		checkStateConsistency(ctx, mf, result)
 
-		require.NoError(t, err)
 		assert.False(t, result.HasErrors())
 	})
 
@@ -607,9 +605,8 @@ func TestCheckStateConsistency(t *testing.T) {
 		}
 
 		result := &CheckResult{}
-		err := checkStateConsistency(ctx, mf, result)
+		checkStateConsistency(ctx, mf, result)
 
-		require.NoError(t, err)
 		assert.True(t, result.HasErrors())
 		require.Len(t, result.Errors, 1)
 		assert.Equal(t, "state-consistency", result.Errors[0].Category)
@@ -653,9 +650,8 @@ func TestCheckStateConsistency(t *testing.T) {
 		}
 
 		result := &CheckResult{}
-		err := checkStateConsistency(ctx, mf, result)
+		checkStateConsistency(ctx, mf, result)
 
-		require.NoError(t, err)
 		assert.True(t, result.HasErrors())
 		require.Len(t, result.Errors, 1)
 		assert.Equal(t, "state-consistency", result.Errors[0].Category)
@@ -664,7 +660,7 @@ func TestCheckStateConsistency(t *testing.T) {
 		assert.Contains(t, result.Errors[0].Suggestion, "Remove this resource")
 	})
 
-	t.Run("returns error when state file does not exist", func(t *testing.T) {
+	t.Run("records a state-load error when state file does not exist", func(t *testing.T) {
 		t.Parallel()
 
 		mf := &MigrationFile{
@@ -679,13 +675,14 @@ func TestCheckStateConsistency(t *testing.T) {
 		}
 
 		result := &CheckResult{}
-		err := checkStateConsistency(ctx, mf, result)
+		checkStateConsistency(ctx, mf, result)
 
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), "failed to load state")
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, "state-load", result.Errors[0].Category)
+		assert.Contains(t, result.Errors[0].Message, "failed to load state")
 	})
 
-	t.Run("returns error when state file is invalid", func(t *testing.T) {
+	t.Run("records a state-load error when state file is invalid", func(t *testing.T) {
 		t.Parallel()
 
 		tmpDir := t.TempDir()
@@ -704,9 +701,10 @@ func TestCheckStateConsistency(t *testing.T) {
 		}
 
 		result := &CheckResult{}
-		err := checkStateConsistency(ctx, mf, result)
+		checkStateConsistency(ctx, mf, result)
 
-		require.Error(t, err)
+		require.Len(t, result.Errors, 1)
+		assert.Equal(t, "state-load", result.Errors[0].Category)
 	})
 }
 
@@ -827,7 +825,7 @@ func TestCheckMigrationIntegrity(t *testing.T) {
 		assert.GreaterOrEqual(t, len(result.Errors), 5)
 	})
 
-	t.Run("returns error when state check fails", func(t *testing.T) {
+	t.Run("continues past a broken state file", func(t *testing.T) {
 		t.Parallel()
 
 		tmpDir := t.TempDir()
@@ -846,13 +844,33 @@ func TestCheckMigrationIntegrity(t *testing.T) {
 						TFState:     "/nonexistent/terraform.tfstate",
 						PulumiStack: "dev",
 					},
+					{
+						PulumiStack: "prod",
+						Resources: []Resource{
+							{
+								TFAddr: "null_resource.web",
+								URN:    "urn:pulumi:prod::proj::null:resource:Resource::web1",
+							},
+							{
+								TFAddr: "null_resource.web",
+								URN:    "urn:pulumi:prod::proj::null:resource:Resource::web2",
+							},
+						},
+					},
 				},
 			},
 		}
 
 		result, err := CheckMigrationIntegrity(ctx, mf)
 
-		require.Error(t, err)
-		assert.Nil(t, result)
+		// A broken state file in one stack must not hide problems found by the other checks.
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		categories := make(map[string]bool)
+		for _, checkErr := range result.Errors {
+			categories[checkErr.Category] = true
+		}
+		assert.True(t, categories["state-load"])
+		assert.True(t, categories["unique-mapping"])
 	})
 }
@@ -0,0 +1,61 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// cleanTempPatterns are the basename patterns of per-run temp files the migration flow writes to
+// the system temp directory; clean removes any that were left behind.
+var cleanTempPatterns = []string{
+	"import-stub-*.json",
+	"import-resolved-*.json",
+}
+
+// CleanArtifacts removes generated temp artifacts a migration accumulates: interrupted-save
+// leftovers (<migration.json>.tmp-*) and the .bak backup next to the migration file, plus
+// orphaned per-run temp files in the system temp directory. The migration file itself is never
+// touched. Returns the removed paths, sorted.
+func CleanArtifacts(migrationPath string) ([]string, error) {
+	dir := filepath.Dir(migrationPath)
+	base := filepath.Base(migrationPath)
+
+	patterns := []string{
+		filepath.Join(dir, base+".tmp-*"),
+		filepath.Join(dir, base+".bak"),
+	}
+	for _, pattern := range cleanTempPatterns {
+		patterns = append(patterns, filepath.Join(os.TempDir(), pattern))
+	}
+
+	var removed []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return removed, err
+		}
+		for _, match := range matches {
+			if err := os.Remove(match); err != nil {
+				return removed, err
+			}
+			removed = append(removed, match)
+		}
+	}
+	sort.Strings(removed)
+	return removed, nil
+}
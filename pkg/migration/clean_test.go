@@ -0,0 +1,65 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanArtifacts(t *testing.T) {
+	// Points os.TempDir at a scratch directory via t.Setenv, so no t.Parallel.
+	tmpDir := t.TempDir()
+	t.Setenv("TMPDIR", t.TempDir())
+
+	migrationPath := filepath.Join(tmpDir, "migration.json")
+	require.NoError(t, os.WriteFile(migrationPath, []byte("{}"), 0o644))
+
+	leftovers := []string{
+		filepath.Join(tmpDir, "migration.json.tmp-123456"),
+		filepath.Join(tmpDir, "migration.json.bak"),
+		filepath.Join(os.TempDir(), "import-stub-42.json"),
+		filepath.Join(os.TempDir(), "import-resolved-42.json"),
+	}
+	for _, path := range leftovers {
+		require.NoError(t, os.WriteFile(path, []byte("{}"), 0o644))
+	}
+	unrelated := filepath.Join(os.TempDir(), "unrelated.json")
+	require.NoError(t, os.WriteFile(unrelated, []byte("{}"), 0o644))
+
+	removed, err := CleanArtifacts(migrationPath)
+	require.NoError(t, err)
+	assert.Len(t, removed, len(leftovers))
+
+	for _, path := range leftovers {
+		_, err := os.Stat(path)
+		assert.True(t, os.IsNotExist(err), "expected %s to be removed", path)
+	}
+
+	// The migration file and unrelated files survive.
+	_, err = os.Stat(migrationPath)
+	assert.NoError(t, err)
+	_, err = os.Stat(unrelated)
+	assert.NoError(t, err)
+
+	// A second run has nothing left to do.
+	removed, err = CleanArtifacts(migrationPath)
+	require.NoError(t, err)
+	assert.Empty(t, removed)
+}
@@ -0,0 +1,243 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+)
+
+// ResourceStatus classifies how a resource stands in the migration.
+type ResourceStatus string
+
+const (
+	// ResourceStatusTracked indicates the resource is present in both the Terraform state and
+	// migration.json with a URN and no special handling.
+	ResourceStatusTracked ResourceStatus = "tracked"
+	// ResourceStatusNotTracked indicates the resource exists in the Terraform state but has no
+	// entry in migration.json.
+	ResourceStatusNotTracked ResourceStatus = "not-tracked"
+	// ResourceStatusNotTranslated indicates the resource has an entry in migration.json but no
+	// URN yet, meaning translation has not produced a Pulumi resource for it.
+	ResourceStatusNotTranslated ResourceStatus = "not-translated"
+	// ResourceStatusNeedsReplace indicates the resource is marked with migrate: "ignore-needs-replace".
+	ResourceStatusNeedsReplace ResourceStatus = "needs-replace"
+	// ResourceStatusNeedsUpdate indicates the resource is marked with migrate: "ignore-needs-update".
+	ResourceStatusNeedsUpdate ResourceStatus = "needs-update"
+	// ResourceStatusSkipped indicates the resource is marked with migrate: "skip".
+	ResourceStatusSkipped ResourceStatus = "skipped"
+	// ResourceStatusStale indicates the resource has an entry in migration.json but no longer
+	// exists in the Terraform state.
+	ResourceStatusStale ResourceStatus = "stale"
+)
+
+// ResourceDiff describes the migration status of a single resource.
+type ResourceDiff struct {
+	// Terraform resource address such as "aws_instance.app_server".
+	TFAddr string `json:"tf-addr"`
+
+	// Pulumi resource URN, if one has been assigned.
+	URN string `json:"urn,omitempty"`
+
+	// Status classifies the resource.
+	Status ResourceStatus `json:"status"`
+
+	// Hash fingerprints the inputs that determined the status: the migration.json entry and
+	// whether the address exists in the Terraform state. Two reports can be compared by hash to
+	// find resources whose inputs changed between runs.
+	Hash string `json:"hash,omitempty"`
+}
+
+// ProviderCount reports how many resources in the Terraform state belong to a provider.
+type ProviderCount struct {
+	// Full provider name such as "registry.terraform.io/hashicorp/aws".
+	Provider string `json:"provider"`
+
+	// Number of resources in the Terraform state using this provider.
+	Resources int `json:"resources"`
+}
+
+// DiffSummary aggregates per-status resource counts for a stack.
+type DiffSummary struct {
+	Total         int `json:"total"`
+	Tracked       int `json:"tracked"`
+	NotTracked    int `json:"not-tracked"`
+	NotTranslated int `json:"not-translated"`
+	NeedsReplace  int `json:"needs-replace"`
+	NeedsUpdate   int `json:"needs-update"`
+	Skipped       int `json:"skipped"`
+	Stale         int `json:"stale"`
+}
+
+// StackDiff holds the full diff for a single stack of the migration.
+type StackDiff struct {
+	// Name of the Pulumi stack such as "dev".
+	PulumiStack string `json:"pulumi-stack"`
+
+	// Per-resource statuses, sorted by Terraform address.
+	Resources []ResourceDiff `json:"resources"`
+
+	// Per-provider resource counts from the Terraform state, sorted by provider name.
+	Providers []ProviderCount `json:"providers"`
+
+	// Aggregated counts.
+	Summary DiffSummary `json:"summary"`
+}
+
+// ComputeDiff compares a stack's migration.json entries against its Terraform state and classifies
+// every resource. Resources present in the state but missing from migration.json are reported as
+// not-tracked; entries without a URN as not-translated; entries whose address no longer exists in
+// the state as stale. The result is deterministic: resources and providers are sorted by name.
+func ComputeDiff(ctx context.Context, stack Stack) (*StackDiff, error) {
+	stateAddrs := map[string]bool{}
+	providerCounts := map[string]int{}
+
+	if stack.TFState != "" {
+		state, err := tofu.LoadTerraformState(ctx, tofu.LoadTerraformStateOptions{
+			StateFilePath: stack.TFState,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load state for stack %s: %w", stack.PulumiStack, err)
+		}
+
+		err = tofu.VisitResources(state, func(res *tfjson.StateResource) error {
+			stateAddrs[res.Address] = true
+			providerCounts[res.ProviderName]++
+			return nil
+		}, nil) // Use default options (skips data sources)
+		if err != nil {
+			return nil, fmt.Errorf("failed to visit resources in state for stack %s: %w", stack.PulumiStack, err)
+		}
+	}
+
+	diff := &StackDiff{PulumiStack: stack.PulumiStack}
+
+	trackedAddrs := map[string]bool{}
+	for _, res := range stack.Resources {
+		if res.TFAddr == "" {
+			continue
+		}
+		trackedAddrs[res.TFAddr] = true
+
+		status := classifyResource(res, stateAddrs[res.TFAddr])
+		diff.Resources = append(diff.Resources, ResourceDiff{
+			TFAddr: res.TFAddr,
+			URN:    res.URN,
+			Status: status,
+			Hash:   hashResourceInputs(res, stateAddrs[res.TFAddr]),
+		})
+	}
+
+	// Resources present in the Terraform state but absent from migration.json.
+	for addr := range stateAddrs {
+		if !trackedAddrs[addr] {
+			diff.Resources = append(diff.Resources, ResourceDiff{
+				TFAddr: addr,
+				Status: ResourceStatusNotTracked,
+				Hash:   hashResourceInputs(Resource{TFAddr: addr}, true),
+			})
+		}
+	}
+
+	sort.Slice(diff.Resources, func(i, j int) bool {
+		return diff.Resources[i].TFAddr < diff.Resources[j].TFAddr
+	})
+
+	for provider, count := range providerCounts {
+		diff.Providers = append(diff.Providers, ProviderCount{Provider: provider, Resources: count})
+	}
+	sort.Slice(diff.Providers, func(i, j int) bool {
+		return diff.Providers[i].Provider < diff.Providers[j].Provider
+	})
+
+	diff.recomputeSummary()
+
+	return diff, nil
+}
+
+// ApplyPreviewStatuses refines the static classification with statuses observed from a Pulumi
+// preview, keyed by URN. Only resources currently considered tracked are refined; entries whose
+// migrate mode already encodes a decision (skipped, ignore-*) keep their static status. The
+// summary is recomputed afterwards.
+func (d *StackDiff) ApplyPreviewStatuses(statuses map[string]ResourceStatus) {
+	for i := range d.Resources {
+		res := &d.Resources[i]
+		if res.Status != ResourceStatusTracked || res.URN == "" {
+			continue
+		}
+		if status, ok := statuses[res.URN]; ok {
+			res.Status = status
+		}
+	}
+	d.recomputeSummary()
+}
+
+// recomputeSummary recalculates the aggregated counts from the per-resource statuses.
+func (d *StackDiff) recomputeSummary() {
+	d.Summary = DiffSummary{}
+	for _, res := range d.Resources {
+		d.Summary.Total++
+		switch res.Status {
+		case ResourceStatusTracked:
+			d.Summary.Tracked++
+		case ResourceStatusNotTracked:
+			d.Summary.NotTracked++
+		case ResourceStatusNotTranslated:
+			d.Summary.NotTranslated++
+		case ResourceStatusNeedsReplace:
+			d.Summary.NeedsReplace++
+		case ResourceStatusNeedsUpdate:
+			d.Summary.NeedsUpdate++
+		case ResourceStatusSkipped:
+			d.Summary.Skipped++
+		case ResourceStatusStale:
+			d.Summary.Stale++
+		}
+	}
+}
+
+// hashResourceInputs fingerprints the inputs that determine a resource's diff status.
+func hashResourceInputs(res Resource, inState bool) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s|%s|%s|%t", res.TFAddr, res.URN, res.Migrate, inState)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// classifyResource determines the status of a migration.json entry. inState reports whether the
+// entry's address exists in the Terraform state.
+func classifyResource(res Resource, inState bool) ResourceStatus {
+	switch res.Migrate {
+	case MigrateModeSkip:
+		return ResourceStatusSkipped
+	case MigrateModeIgnoreNeedsReplace:
+		return ResourceStatusNeedsReplace
+	case MigrateModeIgnoreNeedsUpdate:
+		return ResourceStatusNeedsUpdate
+	}
+
+	if !inState {
+		return ResourceStatusStale
+	}
+	if res.URN == "" {
+		return ResourceStatusNotTranslated
+	}
+	return ResourceStatusTracked
+}
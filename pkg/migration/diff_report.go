@@ -0,0 +1,83 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// DiffReport is the serialized output of the diff command. A saved report can be passed back via
+// `diff --since` to only re-evaluate resources whose inputs changed between runs.
+type DiffReport struct {
+	Stacks []*StackDiff `json:"stacks"`
+}
+
+// LoadDiffReport reads and parses a previously saved diff report.
+func LoadDiffReport(path string) (*DiffReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var report DiffReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// Save writes the diff report to disk.
+func (r *DiffReport) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// FindStack returns the diff for the named stack, or nil if the report does not contain it.
+func (r *DiffReport) FindStack(pulumiStack string) *StackDiff {
+	for _, stack := range r.Stacks {
+		if stack.PulumiStack == pulumiStack {
+			return stack
+		}
+	}
+	return nil
+}
+
+// ChangedSince returns the resources in current whose inputs changed relative to the previous
+// diff: resources that are new, and resources whose input hash differs. When previous is nil
+// (no usable cache), all resources are considered changed so callers fall back to a full diff.
+func ChangedSince(previous, current *StackDiff) []ResourceDiff {
+	if previous == nil {
+		return current.Resources
+	}
+
+	previousHashes := make(map[string]string, len(previous.Resources))
+	for _, res := range previous.Resources {
+		previousHashes[res.TFAddr] = res.Hash
+	}
+
+	var changed []ResourceDiff
+	for _, res := range current.Resources {
+		if hash, ok := previousHashes[res.TFAddr]; !ok || hash == "" || hash != res.Hash {
+			changed = append(changed, res)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,120 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffReportRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stateFile := writeDiffTestState(t)
+
+	stack := Stack{
+		TFState:     stateFile,
+		PulumiStack: "dev",
+		Resources: []Resource{
+			{
+				TFAddr: "null_resource.web",
+				URN:    "urn:pulumi:dev::proj::null:resource:Resource::web",
+			},
+		},
+	}
+
+	diff, err := ComputeDiff(ctx, stack)
+	require.NoError(t, err)
+
+	report := &DiffReport{Stacks: []*StackDiff{diff}}
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, report.Save(reportPath))
+
+	loaded, err := LoadDiffReport(reportPath)
+	require.NoError(t, err)
+	require.Len(t, loaded.Stacks, 1)
+	assert.Equal(t, diff, loaded.Stacks[0])
+
+	assert.NotNil(t, loaded.FindStack("dev"))
+	assert.Nil(t, loaded.FindStack("prod"))
+}
+
+func TestChangedSince(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	stateFile := writeDiffTestState(t)
+
+	stack := Stack{
+		TFState:     stateFile,
+		PulumiStack: "dev",
+		Resources: []Resource{
+			{
+				TFAddr: "null_resource.web",
+				URN:    "urn:pulumi:dev::proj::null:resource:Resource::web",
+			},
+			{
+				TFAddr: "null_resource.data",
+			},
+		},
+	}
+
+	previous, err := ComputeDiff(ctx, stack)
+	require.NoError(t, err)
+
+	t.Run("no changes yields empty result", func(t *testing.T) {
+		t.Parallel()
+
+		current, err := ComputeDiff(ctx, stack)
+		require.NoError(t, err)
+
+		assert.Empty(t, ChangedSince(previous, current))
+	})
+
+	t.Run("changed entry is reported", func(t *testing.T) {
+		t.Parallel()
+
+		changedStack := stack
+		changedStack.Resources = []Resource{
+			stack.Resources[0],
+			{
+				TFAddr: "null_resource.data",
+				URN:    "urn:pulumi:dev::proj::null:resource:Resource::data",
+			},
+		}
+
+		current, err := ComputeDiff(ctx, changedStack)
+		require.NoError(t, err)
+
+		changed := ChangedSince(previous, current)
+		require.Len(t, changed, 1)
+		assert.Equal(t, "null_resource.data", changed[0].TFAddr)
+		assert.Equal(t, ResourceStatusTracked, changed[0].Status)
+	})
+
+	t.Run("nil previous falls back to all resources", func(t *testing.T) {
+		t.Parallel()
+
+		current, err := ComputeDiff(ctx, stack)
+		require.NoError(t, err)
+
+		assert.Len(t, ChangedSince(nil, current), len(current.Resources))
+	})
+}
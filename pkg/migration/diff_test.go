@@ -0,0 +1,234 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeDiffTestState writes a minimal tfjson-format state with two null resources to a temp file.
+func writeDiffTestState(t *testing.T) string {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	stateFile := filepath.Join(tmpDir, "terraform.tfstate.json")
+
+	stateContent := `{
+  "format_version": "1.0",
+  "values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "null_resource.web",
+          "mode": "managed",
+          "type": "null_resource",
+          "name": "web",
+          "provider_name": "registry.terraform.io/hashicorp/null"
+        },
+        {
+          "address": "null_resource.data",
+          "mode": "managed",
+          "type": "null_resource",
+          "name": "data",
+          "provider_name": "registry.terraform.io/hashicorp/null"
+        }
+      ]
+    }
+  }
+}`
+	require.NoError(t, os.WriteFile(stateFile, []byte(stateContent), 0644))
+	return stateFile
+}
+
+func TestComputeDiff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("classifies resources by status", func(t *testing.T) {
+		t.Parallel()
+
+		stateFile := writeDiffTestState(t)
+
+		stack := Stack{
+			TFState:     stateFile,
+			PulumiStack: "dev",
+			Resources: []Resource{
+				{
+					TFAddr: "null_resource.web",
+					URN:    "urn:pulumi:dev::proj::null:resource:Resource::web",
+				},
+				{
+					TFAddr: "null_resource.data",
+					// No URN: translation has not run yet.
+				},
+				{
+					TFAddr:  "null_resource.gone",
+					Migrate: MigrateModeSkip,
+				},
+			},
+		}
+
+		diff, err := ComputeDiff(ctx, stack)
+		require.NoError(t, err)
+
+		assert.Equal(t, "dev", diff.PulumiStack)
+		assert.Equal(t, 3, diff.Summary.Total)
+		assert.Equal(t, 1, diff.Summary.Tracked)
+		assert.Equal(t, 1, diff.Summary.NotTranslated)
+		assert.Equal(t, 1, diff.Summary.Skipped)
+		assert.Equal(t, 0, diff.Summary.NotTracked)
+
+		require.Len(t, diff.Providers, 1)
+		assert.Equal(t, "registry.terraform.io/hashicorp/null", diff.Providers[0].Provider)
+		assert.Equal(t, 2, diff.Providers[0].Resources)
+	})
+
+	t.Run("reports not-tracked and stale resources", func(t *testing.T) {
+		t.Parallel()
+
+		stateFile := writeDiffTestState(t)
+
+		stack := Stack{
+			TFState:     stateFile,
+			PulumiStack: "dev",
+			Resources: []Resource{
+				{
+					TFAddr: "null_resource.web",
+					URN:    "urn:pulumi:dev::proj::null:resource:Resource::web",
+				},
+				{
+					TFAddr: "null_resource.removed",
+					URN:    "urn:pulumi:dev::proj::null:resource:Resource::removed",
+				},
+			},
+		}
+
+		diff, err := ComputeDiff(ctx, stack)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, diff.Summary.Total)
+		assert.Equal(t, 1, diff.Summary.Tracked)
+		assert.Equal(t, 1, diff.Summary.NotTracked)
+		assert.Equal(t, 1, diff.Summary.Stale)
+
+		// Resources are sorted by Terraform address.
+		require.Len(t, diff.Resources, 3)
+		assert.Equal(t, "null_resource.data", diff.Resources[0].TFAddr)
+		assert.Equal(t, ResourceStatusNotTracked, diff.Resources[0].Status)
+		assert.Equal(t, "null_resource.removed", diff.Resources[1].TFAddr)
+		assert.Equal(t, ResourceStatusStale, diff.Resources[1].Status)
+		assert.Equal(t, "null_resource.web", diff.Resources[2].TFAddr)
+		assert.Equal(t, ResourceStatusTracked, diff.Resources[2].Status)
+	})
+
+	t.Run("handles stack without tf-state", func(t *testing.T) {
+		t.Parallel()
+
+		stack := Stack{
+			PulumiStack: "dev",
+			Resources: []Resource{
+				{
+					TFAddr:  "null_resource.web",
+					Migrate: MigrateModeSkip,
+				},
+			},
+		}
+
+		diff, err := ComputeDiff(ctx, stack)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, diff.Summary.Total)
+		assert.Equal(t, 1, diff.Summary.Skipped)
+		assert.Empty(t, diff.Providers)
+	})
+}
+
+func TestApplyPreviewStatuses(t *testing.T) {
+	t.Parallel()
+
+	diff := &StackDiff{
+		PulumiStack: "dev",
+		Resources: []ResourceDiff{
+			{
+				TFAddr: "null_resource.data",
+				URN:    "urn:pulumi:dev::proj::null:index/resource:Resource::data",
+				Status: ResourceStatusTracked,
+			},
+			{
+				TFAddr: "null_resource.skipped",
+				Status: ResourceStatusSkipped,
+			},
+			{
+				TFAddr: "null_resource.web",
+				URN:    "urn:pulumi:dev::proj::null:index/resource:Resource::web",
+				Status: ResourceStatusTracked,
+			},
+		},
+	}
+	diff.recomputeSummary()
+
+	diff.ApplyPreviewStatuses(map[string]ResourceStatus{
+		"urn:pulumi:dev::proj::null:index/resource:Resource::web":     ResourceStatusNeedsUpdate,
+		"urn:pulumi:dev::proj::null:index/resource:Resource::skipped": ResourceStatusNeedsReplace,
+	})
+
+	assert.Equal(t, ResourceStatusTracked, diff.Resources[0].Status)
+	assert.Equal(t, ResourceStatusSkipped, diff.Resources[1].Status,
+		"resources with an explicit migrate decision keep their static status")
+	assert.Equal(t, ResourceStatusNeedsUpdate, diff.Resources[2].Status)
+
+	assert.Equal(t, 3, diff.Summary.Total)
+	assert.Equal(t, 1, diff.Summary.Tracked)
+	assert.Equal(t, 1, diff.Summary.NeedsUpdate)
+	assert.Equal(t, 1, diff.Summary.Skipped)
+}
+
+func TestRenderMarkdownReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	stateFile := writeDiffTestState(t)
+
+	stack := Stack{
+		TFState:     stateFile,
+		PulumiStack: "dev",
+		Resources: []Resource{
+			{
+				TFAddr: "null_resource.web",
+				URN:    "urn:pulumi:dev::proj::null:resource:Resource::web",
+			},
+		},
+	}
+
+	diff, err := ComputeDiff(ctx, stack)
+	require.NoError(t, err)
+
+	report := RenderMarkdownReport([]*StackDiff{diff})
+
+	assert.Contains(t, report, "# Migration Report")
+	assert.Contains(t, report, "## Stack dev")
+	assert.Contains(t, report, "| Not tracked | 1 |")
+	assert.Contains(t, report, "### Not tracked")
+	assert.Contains(t, report, "| null_resource.data | - |")
+	assert.Contains(t, report, "| registry.terraform.io/hashicorp/null | 2 |")
+}
@@ -0,0 +1,136 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto"
+)
+
+// StackResourceAddresses loads the Terraform state referenced by a stack and returns the sorted
+// addresses of its managed resources. Data sources are skipped.
+func StackResourceAddresses(ctx context.Context, stack Stack) ([]string, error) {
+	state, err := tofu.LoadTerraformState(ctx, tofu.LoadTerraformStateOptions{
+		StateFilePath: stack.TFState,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load state for stack %s: %w", stack.PulumiStack, err)
+	}
+
+	var addrs []string
+	err = tofu.VisitResources(state, func(res *tfjson.StateResource) error {
+		addrs = append(addrs, res.Address)
+		return nil
+	}, nil) // Use default options (skips data sources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to visit resources in state for stack %s: %w", stack.PulumiStack, err)
+	}
+
+	sort.Strings(addrs)
+	return addrs, nil
+}
+
+// MergeSummary reports what MergeStack changed.
+type MergeSummary struct {
+	// Addresses newly added to the stack's resource list.
+	Added []string
+
+	// Addresses of entries that no longer exist in the Terraform state. The entries are kept and
+	// flagged via Reason rather than deleted, preserving any user-edited URN mappings.
+	Removed []string
+}
+
+// removedReason flags migration.json entries whose Terraform address disappeared from the state.
+const removedReason = "no longer present in the Terraform state"
+
+// MergeStack reconciles a stack's resource entries with the current addresses of its Terraform
+// state: addresses missing from the stack are added as untranslated stubs, entries whose address
+// disappeared from the state are flagged through their Reason field, and existing entries —
+// including user-edited URN mappings — are otherwise left intact. An entry flagged as removed by a
+// previous merge is unflagged when its address reappears.
+func MergeStack(stack *Stack, stateAddrs []string) MergeSummary {
+	inState := map[string]bool{}
+	for _, addr := range stateAddrs {
+		inState[addr] = true
+	}
+
+	summary := MergeSummary{}
+
+	tracked := map[string]bool{}
+	for i := range stack.Resources {
+		res := &stack.Resources[i]
+		if res.TFAddr == "" {
+			continue
+		}
+		tracked[res.TFAddr] = true
+		switch {
+		case !inState[res.TFAddr] && res.Reason == "":
+			res.Reason = removedReason
+			summary.Removed = append(summary.Removed, res.TFAddr)
+		case inState[res.TFAddr] && res.Reason == removedReason:
+			res.Reason = ""
+		}
+	}
+
+	for _, addr := range stateAddrs {
+		if !tracked[addr] {
+			stack.Resources = append(stack.Resources, Resource{
+				TFAddr: addr,
+				Key:    TerraformInstanceKey(addr),
+			})
+			summary.Added = append(summary.Added, addr)
+		}
+	}
+
+	sort.Strings(summary.Added)
+	sort.Strings(summary.Removed)
+	return summary
+}
+
+// DetectPulumiStack infers the Pulumi stack name from the project at pulumiSources. It succeeds
+// only when the project has exactly one stack; with zero or several stacks the caller has to name
+// the stack explicitly, and the error lists the candidates.
+func DetectPulumiStack(ctx context.Context, pulumiSources string) (string, error) {
+	workspace, err := auto.NewLocalWorkspace(ctx, auto.WorkDir(pulumiSources))
+	if err != nil {
+		return "", fmt.Errorf("failed to create workspace: %w", err)
+	}
+
+	stacks, err := workspace.ListStacks(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list Pulumi stacks: %w", err)
+	}
+
+	switch len(stacks) {
+	case 0:
+		return "", fmt.Errorf("no Pulumi stacks found in %s; create one with `pulumi stack init` or pass --stack",
+			pulumiSources)
+	case 1:
+		return stacks[0].Name, nil
+	default:
+		names := make([]string, len(stacks))
+		for i, stack := range stacks {
+			names[i] = stack.Name
+		}
+		return "", fmt.Errorf("project %s has %d stacks (%s); pass --stack to pick one",
+			pulumiSources, len(stacks), strings.Join(names, ", "))
+	}
+}
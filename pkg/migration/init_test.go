@@ -0,0 +1,80 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MergeStack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("adds new resources and flags removed ones", func(t *testing.T) {
+		t.Parallel()
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources: []Resource{
+				{TFAddr: "aws_instance.app", URN: "urn:pulumi:dev::proj::aws:ec2/instance:Instance::app"},
+				{TFAddr: "aws_instance.old"},
+			},
+		}
+
+		summary := MergeStack(stack, []string{"aws_instance.app", `aws_instance.web["a"]`})
+
+		assert.Equal(t, []string{`aws_instance.web["a"]`}, summary.Added)
+		assert.Equal(t, []string{"aws_instance.old"}, summary.Removed)
+
+		require.Len(t, stack.Resources, 3)
+		// The user-edited URN mapping survives the merge.
+		assert.Equal(t, "urn:pulumi:dev::proj::aws:ec2/instance:Instance::app", stack.Resources[0].URN)
+		assert.Empty(t, stack.Resources[0].Reason)
+		// Removed entries are flagged, not deleted.
+		assert.Equal(t, removedReason, stack.Resources[1].Reason)
+		// New keyed instances record their for_each key.
+		assert.Equal(t, `aws_instance.web["a"]`, stack.Resources[2].TFAddr)
+		assert.Equal(t, "a", stack.Resources[2].Key)
+	})
+
+	t.Run("unflags entries that reappear in the state", func(t *testing.T) {
+		t.Parallel()
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources:   []Resource{{TFAddr: "aws_instance.app", Reason: removedReason}},
+		}
+
+		summary := MergeStack(stack, []string{"aws_instance.app"})
+
+		assert.Empty(t, summary.Added)
+		assert.Empty(t, summary.Removed)
+		assert.Empty(t, stack.Resources[0].Reason)
+	})
+
+	t.Run("merge is idempotent", func(t *testing.T) {
+		t.Parallel()
+		stack := &Stack{PulumiStack: "dev"}
+		addrs := []string{"aws_instance.a", "aws_instance.b"}
+
+		first := MergeStack(stack, addrs)
+		assert.Len(t, first.Added, 2)
+
+		second := MergeStack(stack, addrs)
+		assert.Empty(t, second.Added)
+		assert.Empty(t, second.Removed)
+		assert.Len(t, stack.Resources, 2)
+	})
+}
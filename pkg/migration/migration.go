@@ -16,7 +16,11 @@ package migration
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
+	"path/filepath"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 )
 
 // MigrateMode represents the migration status or handling of a resource
@@ -33,10 +37,23 @@ const (
 	MigrateModeIgnoreNeedsUpdate MigrateMode = "ignore-needs-update"
 	// MigrateModeIgnoreNeedsUpdate indicates the resource that has state but wants to replace on preview can be skipped
 	MigrateModeIgnoreNeedsReplace MigrateMode = "ignore-needs-replace"
+	// MigrateModeNew indicates a Pulumi-native resource with no Terraform counterpart. The entry
+	// usually has a URN and no tf-addr; it is neither imported nor checked against the Terraform
+	// state, so mixed stacks resolve without forcing a skip entry.
+	MigrateModeNew MigrateMode = "new"
 )
 
+// CurrentMigrationFileVersion is the version of the migration.json format written by this tool.
+// Older files are upgraded on load; newer files are rejected so users don't silently lose data
+// when sharing migration.json across tool versions.
+const CurrentMigrationFileVersion = 1
+
 // MigrationFile represents the top-level structure of migration.json
 type MigrationFile struct {
+	// Version of the migration.json format. Files written before versioning was introduced have
+	// no version field and are treated as version 0, then upgraded on load.
+	Version int `json:"version,omitempty"`
+
 	Migration Migration `json:"migration"`
 }
 
@@ -77,6 +94,25 @@ type Resource struct {
 	// Encode how the particular Terraform resource should be migrated, can it be skipped completely or can certain
 	// checks for this resource be ignored.
 	Migrate MigrateMode `json:"migrate,omitempty"`
+
+	// Optional human-readable explanation of why the resource is skipped or ignored, recorded so
+	// the decision is reviewable later.
+	Reason string `json:"reason,omitempty"`
+
+	// PulumiName is the Pulumi resource name chosen during translation. Recorded when a
+	// non-default naming strategy is in effect, so URN resolution and source-side matching use
+	// the chosen name instead of re-deriving the default one from the Terraform address.
+	PulumiName string `json:"pulumi-name,omitempty"`
+
+	// PulumiType optionally overrides the Pulumi type token computed from the provider mapping,
+	// e.g. "custom:index/thing:Thing". An escape hatch for setups where the automatic mapping is
+	// wrong (custom bridges); URN resolution only accepts stack resources of this type.
+	PulumiType string `json:"pulumi-type,omitempty"`
+
+	// Key is the original for_each key or count index of the Terraform instance, e.g. "a" for
+	// aws_instance.web["a"] or "0" for aws_instance.web[0]. Recording it lets source translation
+	// reconstruct the for_each map or count loop. Empty for unkeyed resources.
+	Key string `json:"key,omitempty"`
 }
 
 // LoadMigration reads and parses a migration.json file
@@ -91,15 +127,110 @@ func LoadMigration(path string) (*MigrationFile, error) {
 		return nil, err
 	}
 
+	if err := upgradeMigrationFile(&mf); err != nil {
+		return nil, err
+	}
+
+	if err := validateStacks(&mf); err != nil {
+		return nil, err
+	}
+
+	if err := validateResources(&mf); err != nil {
+		return nil, err
+	}
+
 	return &mf, nil
 }
 
+// validateResources rejects resource entries whose pulumi-type override is not a valid Pulumi
+// type token, so a typo surfaces on load rather than as a silent non-match during resolution.
+func validateResources(mf *MigrationFile) error {
+	for _, stack := range mf.Migration.Stacks {
+		for _, res := range stack.Resources {
+			if res.PulumiType == "" {
+				continue
+			}
+			if _, err := tokens.ParseTypeToken(res.PulumiType); err != nil {
+				return fmt.Errorf("resource %s has an invalid pulumi-type %q: %w",
+					res.TFAddr, res.PulumiType, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateStacks rejects migration files with duplicate stack names. Operations that resolve a
+// stack by name would otherwise silently act on only the first match.
+func validateStacks(mf *MigrationFile) error {
+	seen := map[string]bool{}
+	for _, stack := range mf.Migration.Stacks {
+		if stack.PulumiStack == "" {
+			continue
+		}
+		if seen[stack.PulumiStack] {
+			return fmt.Errorf(
+				"migration file has more than one stack named %q; merge their resources into a single entry",
+				stack.PulumiStack)
+		}
+		seen[stack.PulumiStack] = true
+	}
+	return nil
+}
+
+// upgradeMigrationFile brings an older migration file up to the current format version. Fields
+// introduced in later versions are left at their zero values, which is the correct default for
+// every upgrade so far. Files newer than this tool supports are rejected.
+func upgradeMigrationFile(mf *MigrationFile) error {
+	if mf.Version > CurrentMigrationFileVersion {
+		return fmt.Errorf(
+			"migration file version %d is newer than the latest supported version %d; "+
+				"please upgrade pulumi-terraform-migrate",
+			mf.Version, CurrentMigrationFileVersion)
+	}
+
+	mf.Version = CurrentMigrationFileVersion
+	return nil
+}
+
 // Save writes the migration file to disk
 func (mf *MigrationFile) Save(path string) error {
+	if mf.Version == 0 {
+		mf.Version = CurrentMigrationFileVersion
+	}
+
 	data, err := json.MarshalIndent(mf, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(path, data, 0644)
+	// The file is saved at many points as a migration progresses and may carry hand-edited
+	// mappings, so a partial write must never clobber it: write to a temp file in the same
+	// directory and rename into place, keeping a .bak of the previous version.
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Chmod(tmpPath, 0644); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		if err := os.Rename(path, path+".bak"); err != nil {
+			os.Remove(tmpPath)
+			return err
+		}
+	}
+
+	return os.Rename(tmpPath, path)
 }
@@ -101,6 +101,113 @@ func TestLoadMigration(t *testing.T) {
 		_, err = LoadMigration(migrationPath)
 		assert.Error(t, err)
 	})
+
+	t.Run("rejects an invalid pulumi-type override", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := t.TempDir()
+		migrationPath := filepath.Join(tmpDir, "migration.json")
+
+		content := `{
+  "migration": {
+    "stacks": [
+      {
+        "pulumi-stack": "dev",
+        "resources": [
+          {"tf-addr": "aws_instance.web", "pulumi-type": "not a token"}
+        ]
+      }
+    ]
+  }
+}`
+		require.NoError(t, os.WriteFile(migrationPath, []byte(content), 0644))
+
+		_, err := LoadMigration(migrationPath)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid pulumi-type "not a token"`)
+		assert.Contains(t, err.Error(), "aws_instance.web")
+	})
+
+	t.Run("rejects duplicate stack names", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := t.TempDir()
+		migrationPath := filepath.Join(tmpDir, "migration.json")
+
+		content := `{
+  "migration": {
+    "stacks": [
+      {"pulumi-stack": "dev"},
+      {"pulumi-stack": "prod"},
+      {"pulumi-stack": "dev"}
+    ]
+  }
+}`
+		require.NoError(t, os.WriteFile(migrationPath, []byte(content), 0644))
+
+		_, err := LoadMigration(migrationPath)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `more than one stack named "dev"`)
+		assert.Contains(t, err.Error(), "merge")
+	})
+}
+
+func TestMigrationFileVersioning(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unversioned file upgrades to current version", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := t.TempDir()
+		migrationPath := filepath.Join(tmpDir, "migration.json")
+
+		content := `{
+  "migration": {
+    "tf-sources": "./terraform",
+    "pulumi-sources": "./pulumi"
+  }
+}`
+		require.NoError(t, os.WriteFile(migrationPath, []byte(content), 0644))
+
+		mf, err := LoadMigration(migrationPath)
+		require.NoError(t, err)
+		assert.Equal(t, CurrentMigrationFileVersion, mf.Version)
+		assert.Equal(t, "./terraform", mf.Migration.TFSources)
+	})
+
+	t.Run("rejects newer-than-supported version", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := t.TempDir()
+		migrationPath := filepath.Join(tmpDir, "migration.json")
+
+		content := `{"version": 99, "migration": {}}`
+		require.NoError(t, os.WriteFile(migrationPath, []byte(content), 0644))
+
+		_, err := LoadMigration(migrationPath)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "newer than the latest supported version")
+		assert.Contains(t, err.Error(), "upgrade pulumi-terraform-migrate")
+	})
+
+	t.Run("version round-trips through save and load", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := t.TempDir()
+		migrationPath := filepath.Join(tmpDir, "migration.json")
+
+		mf := &MigrationFile{
+			Migration: Migration{
+				TFSources: "./terraform",
+			},
+		}
+		require.NoError(t, mf.Save(migrationPath))
+
+		loaded, err := LoadMigration(migrationPath)
+		require.NoError(t, err)
+		assert.Equal(t, CurrentMigrationFileVersion, loaded.Version)
+		assert.Equal(t, mf.Migration.TFSources, loaded.Migration.TFSources)
+	})
 }
 
 func TestMigrationFile_Save(t *testing.T) {
@@ -163,4 +270,34 @@ func TestMigrationFile_Save(t *testing.T) {
 		err := mf.Save("/invalid/directory/that/does/not/exist/migration.json")
 		assert.Error(t, err)
 	})
+
+	t.Run("keeps a backup of the previous version", func(t *testing.T) {
+		t.Parallel()
+
+		tmpDir := t.TempDir()
+		migrationPath := filepath.Join(tmpDir, "migration.json")
+
+		mf := &MigrationFile{Migration: Migration{TFSources: "./terraform-v1"}}
+		require.NoError(t, mf.Save(migrationPath))
+
+		// The first save has nothing to back up.
+		_, err := os.Stat(migrationPath + ".bak")
+		require.True(t, os.IsNotExist(err))
+
+		mf.Migration.TFSources = "./terraform-v2"
+		require.NoError(t, mf.Save(migrationPath))
+
+		loaded, err := LoadMigration(migrationPath)
+		require.NoError(t, err)
+		assert.Equal(t, "./terraform-v2", loaded.Migration.TFSources)
+
+		backup, err := LoadMigration(migrationPath + ".bak")
+		require.NoError(t, err)
+		assert.Equal(t, "./terraform-v1", backup.Migration.TFSources)
+
+		// No temp files are left behind.
+		entries, err := os.ReadDir(tmpDir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+	})
 }
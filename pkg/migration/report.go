@@ -0,0 +1,83 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RenderMarkdownReport renders stack diffs into a shareable Markdown migration report. The diffs
+// should come from ComputeDiff so the numbers match the diff command exactly.
+func RenderMarkdownReport(diffs []*StackDiff) string {
+	var sb strings.Builder
+
+	sb.WriteString("# Migration Report\n")
+
+	for _, diff := range diffs {
+		fmt.Fprintf(&sb, "\n## Stack %s\n\n", diff.PulumiStack)
+
+		sb.WriteString("| Status | Count |\n")
+		sb.WriteString("| --- | --- |\n")
+		fmt.Fprintf(&sb, "| Total | %d |\n", diff.Summary.Total)
+		fmt.Fprintf(&sb, "| Tracked | %d |\n", diff.Summary.Tracked)
+		fmt.Fprintf(&sb, "| Not tracked | %d |\n", diff.Summary.NotTracked)
+		fmt.Fprintf(&sb, "| Not translated | %d |\n", diff.Summary.NotTranslated)
+		fmt.Fprintf(&sb, "| Needs replace | %d |\n", diff.Summary.NeedsReplace)
+		fmt.Fprintf(&sb, "| Needs update | %d |\n", diff.Summary.NeedsUpdate)
+		fmt.Fprintf(&sb, "| Skipped | %d |\n", diff.Summary.Skipped)
+		fmt.Fprintf(&sb, "| Stale | %d |\n", diff.Summary.Stale)
+
+		writeResourceSection(&sb, "Not tracked", diff.Resources, ResourceStatusNotTracked)
+		writeResourceSection(&sb, "Not translated", diff.Resources, ResourceStatusNotTranslated)
+		writeResourceSection(&sb, "Needs replace", diff.Resources, ResourceStatusNeedsReplace)
+
+		if len(diff.Providers) > 0 {
+			sb.WriteString("\n### Providers\n\n")
+			sb.WriteString("| Provider | Resources |\n")
+			sb.WriteString("| --- | --- |\n")
+			for _, p := range diff.Providers {
+				fmt.Fprintf(&sb, "| %s | %d |\n", p.Provider, p.Resources)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// writeResourceSection writes a Markdown table listing resources with the given status. The
+// section is omitted entirely when no resources have that status.
+func writeResourceSection(sb *strings.Builder, title string, resources []ResourceDiff, status ResourceStatus) {
+	var matching []ResourceDiff
+	for _, res := range resources {
+		if res.Status == status {
+			matching = append(matching, res)
+		}
+	}
+	if len(matching) == 0 {
+		return
+	}
+
+	fmt.Fprintf(sb, "\n### %s\n\n", title)
+	sb.WriteString("| TF Address | URN |\n")
+	sb.WriteString("| --- | --- |\n")
+	for _, res := range matching {
+		urn := res.URN
+		if urn == "" {
+			urn = "-"
+		}
+		fmt.Fprintf(sb, "| %s | %s |\n", res.TFAddr, urn)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UnresolvedStub describes a migration.json entry without a URN that could not be resolved
+// against the Pulumi stack, along with the tool's best guesses and example entries a user (or
+// tooling) can apply to fix it.
+type UnresolvedStub struct {
+	// Terraform address of the unresolved entry.
+	TFAddr string `json:"tf-addr"`
+
+	// PartialMatches lists URNs in the Pulumi stack whose resource name loosely matches the
+	// name derived from the Terraform address.
+	PartialMatches []string `json:"partial-matches,omitempty"`
+
+	// ExampleEntry is a suggested migration.json entry mapping the resource to the closest
+	// partial match, when one exists.
+	ExampleEntry *Resource `json:"example-entry,omitempty"`
+
+	// ExampleSkipEntry is a suggested migration.json entry that skips the resource instead.
+	ExampleSkipEntry *Resource `json:"example-skip-entry,omitempty"`
+
+	// Ambiguous is set when several stack resources matched the Terraform address exactly, so
+	// tooling can present a disambiguation choice instead of parsing the rendered message.
+	Ambiguous *AmbiguousMapping `json:"ambiguous,omitempty"`
+}
+
+// AmbiguousMapping describes an import stub whose Terraform address matched several stack
+// resources exactly, with one ready-to-apply migration.json entry per candidate.
+type AmbiguousMapping struct {
+	// TFAddr is the Terraform address of the ambiguous entry.
+	TFAddr string `json:"tf-addr"`
+
+	// Candidates are the matching entries; applying exactly one of them resolves the ambiguity.
+	Candidates []Resource `json:"candidates"`
+}
+
+// Error renders the ambiguity as a human-readable message for the CLI path.
+func (a *AmbiguousMapping) Error() string {
+	urns := make([]string, len(a.Candidates))
+	for i, c := range a.Candidates {
+		urns[i] = c.URN
+	}
+	return fmt.Sprintf("%s matches %d resources in the Pulumi stack exactly (%s); "+
+		"set one of their URNs in migration.json",
+		a.TFAddr, len(a.Candidates), strings.Join(urns, ", "))
+}
+
+// ResolveImportStubs fills in URNs for a stack's entries that are missing them ("import stubs")
+// by matching each against the URNs present in the Pulumi stack. An entry resolves when exactly
+// one URN's resource name equals the Pulumi name derived from the Terraform address. Entries that
+// cannot be resolved are returned with partial-match suggestions. Returns the number of entries
+// resolved and the remaining unresolved stubs.
+func ResolveImportStubs(stack *Stack, urns []string) (int, []UnresolvedStub) {
+	resolved := 0
+	var unresolved []UnresolvedStub
+
+	for i := range stack.Resources {
+		res := &stack.Resources[i]
+		if res.URN != "" || res.TFAddr == "" ||
+			res.Migrate == MigrateModeSkip || res.Migrate == MigrateModeNew {
+			continue
+		}
+
+		expectedName := res.PulumiName
+		if expectedName == "" {
+			expectedName = pulumiNameForAddr(res.TFAddr)
+		}
+
+		var exact, partial []string
+		for _, urn := range urns {
+			// A pulumi-type override restricts matching to stack resources of that type.
+			if res.PulumiType != "" && urnType(urn) != res.PulumiType {
+				continue
+			}
+			name := urnName(urn)
+			switch {
+			case name == expectedName:
+				exact = append(exact, urn)
+			case strings.Contains(name, expectedName) || strings.Contains(expectedName, name):
+				partial = append(partial, urn)
+			}
+		}
+
+		if len(exact) == 1 {
+			res.URN = exact[0]
+			if res.Key == "" {
+				res.Key = TerraformInstanceKey(res.TFAddr)
+			}
+			resolved++
+			continue
+		}
+
+		// Multiple exact matches are ambiguous; record them as structured candidates and also
+		// surface them as partial matches for review.
+		var ambiguous *AmbiguousMapping
+		if len(exact) > 1 {
+			sort.Strings(exact)
+			ambiguous = &AmbiguousMapping{TFAddr: res.TFAddr}
+			for _, urn := range exact {
+				ambiguous.Candidates = append(ambiguous.Candidates, Resource{
+					TFAddr: res.TFAddr,
+					URN:    urn,
+					Key:    TerraformInstanceKey(res.TFAddr),
+				})
+			}
+		}
+		partial = append(exact, partial...)
+		sort.Strings(partial)
+
+		stub := UnresolvedStub{
+			TFAddr:         res.TFAddr,
+			PartialMatches: partial,
+			Ambiguous:      ambiguous,
+			ExampleSkipEntry: &Resource{
+				TFAddr:  res.TFAddr,
+				Migrate: MigrateModeSkip,
+			},
+		}
+		if len(partial) > 0 {
+			stub.ExampleEntry = &Resource{
+				TFAddr: res.TFAddr,
+				URN:    partial[0],
+				Key:    TerraformInstanceKey(res.TFAddr),
+			}
+		}
+		unresolved = append(unresolved, stub)
+	}
+
+	return resolved, unresolved
+}
+
+// FormatUnresolvedStubs renders unresolved stubs as a human-readable message with partial-match
+// suggestions and example migration.json entries.
+func FormatUnresolvedStubs(pulumiStack string, stubs []UnresolvedStub) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Stack %s has %d unresolved resources:\n", pulumiStack, len(stubs))
+	for _, stub := range stubs {
+		if stub.Ambiguous != nil {
+			fmt.Fprintf(&sb, "\n  %s\n", stub.Ambiguous.Error())
+		} else {
+			fmt.Fprintf(&sb, "\n  %s: no matching Pulumi resource found\n", stub.TFAddr)
+		}
+		if len(stub.PartialMatches) > 0 {
+			sb.WriteString("    Did you mean one of:\n")
+			for _, urn := range stub.PartialMatches {
+				fmt.Fprintf(&sb, "      %s\n", urn)
+			}
+		}
+		fmt.Fprintf(&sb, "    To skip this resource, add to migration.json: "+
+			`{"tf-addr": %q, "migrate": "skip"}`+"\n", stub.TFAddr)
+	}
+
+	return sb.String()
+}
+
+// pulumiNameForAddr derives the expected Pulumi resource name from a Terraform address, using the
+// same naming rule the translation applies.
+func pulumiNameForAddr(addr string) string {
+	resourceType := addrResourceType(addr)
+
+	// Inline the module-path aware extraction from pkg.PulumiNameFromTerraformAddress; importing
+	// pkg here would create an import cycle.
+	parts := strings.Split(addr, ".")
+	var nameParts []string
+	for i := 0; i < len(parts); i++ {
+		if parts[i] == resourceType {
+			nameParts = append(nameParts, parts[i+1:]...)
+			break
+		}
+		if parts[i] == "module" && i+1 < len(parts) {
+			nameParts = append(nameParts, parts[i+1])
+			i++
+		}
+	}
+	name := strings.Join(nameParts, "_")
+
+	// Strip any instance key such as [0] or ["key"].
+	if idx := strings.Index(name, "["); idx >= 0 {
+		name = name[:idx]
+	}
+	return name
+}
+
+// TerraformInstanceKey extracts the for_each key or count index from an instanced Terraform
+// address: "a" for aws_instance.web["a"], "0" for aws_instance.web[0]. Returns "" for addresses
+// without an instance key.
+func TerraformInstanceKey(addr string) string {
+	if !strings.HasSuffix(addr, "]") {
+		return ""
+	}
+	open := strings.LastIndex(addr, "[")
+	if open < 0 {
+		return ""
+	}
+	return strings.Trim(addr[open+1:len(addr)-1], `"`)
+}
+
+// addrResourceType extracts the resource type from a Terraform address, e.g. "aws_instance" from
+// "module.app.aws_instance.web[0]".
+func addrResourceType(addr string) string {
+	parts := strings.Split(addr, ".")
+	for i := 0; i < len(parts); i++ {
+		if parts[i] == "module" && i+1 < len(parts) {
+			i++
+			continue
+		}
+		return parts[i]
+	}
+	return addr
+}
+
+// urnName extracts the resource name (the final component) from a Pulumi URN.
+func urnName(urn string) string {
+	parts := strings.Split(urn, "::")
+	return parts[len(parts)-1]
+}
+
+// urnType extracts the type token from a Pulumi URN such as
+// urn:pulumi:dev::proj::aws:s3/bucket:Bucket::my-bucket.
+func urnType(urn string) string {
+	parts := strings.Split(urn, "::")
+	if len(parts) != 4 {
+		return ""
+	}
+	return parts[2]
+}
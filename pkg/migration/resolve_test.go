@@ -0,0 +1,210 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveImportStubs(t *testing.T) {
+	t.Parallel()
+
+	urns := []string{
+		"urn:pulumi:dev::proj::aws:ec2/instance:Instance::web",
+		"urn:pulumi:dev::proj::aws:s3/bucket:Bucket::logs_archive",
+		"urn:pulumi:dev::proj::aws:s3/bucket:Bucket::logs_backup",
+	}
+
+	t.Run("resolves a single exact match", func(t *testing.T) {
+		t.Parallel()
+
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources:   []Resource{{TFAddr: "aws_instance.web"}},
+		}
+
+		resolved, unresolved := ResolveImportStubs(stack, urns)
+		assert.Equal(t, 1, resolved)
+		assert.Empty(t, unresolved)
+		assert.Equal(t, urns[0], stack.Resources[0].URN)
+	})
+
+	t.Run("reports partial matches for unresolved stubs", func(t *testing.T) {
+		t.Parallel()
+
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources:   []Resource{{TFAddr: "aws_s3_bucket.logs"}},
+		}
+
+		resolved, unresolved := ResolveImportStubs(stack, urns)
+		assert.Equal(t, 0, resolved)
+		require.Len(t, unresolved, 1)
+
+		stub := unresolved[0]
+		assert.Equal(t, "aws_s3_bucket.logs", stub.TFAddr)
+		assert.Equal(t, []string{
+			"urn:pulumi:dev::proj::aws:s3/bucket:Bucket::logs_archive",
+			"urn:pulumi:dev::proj::aws:s3/bucket:Bucket::logs_backup",
+		}, stub.PartialMatches)
+		require.NotNil(t, stub.ExampleEntry)
+		assert.Equal(t, stub.PartialMatches[0], stub.ExampleEntry.URN)
+		require.NotNil(t, stub.ExampleSkipEntry)
+		assert.Equal(t, MigrateModeSkip, stub.ExampleSkipEntry.Migrate)
+	})
+
+	t.Run("multiple exact matches are recorded as an ambiguous mapping", func(t *testing.T) {
+		t.Parallel()
+
+		ambiguousURNs := []string{
+			"urn:pulumi:dev::proj::aws:ec2/instance:Instance::web",
+			"urn:pulumi:dev::proj::aws:lightsail/instance:Instance::web",
+		}
+
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources:   []Resource{{TFAddr: "aws_instance.web"}},
+		}
+
+		resolved, unresolved := ResolveImportStubs(stack, ambiguousURNs)
+		assert.Equal(t, 0, resolved)
+		require.Len(t, unresolved, 1)
+
+		stub := unresolved[0]
+		require.NotNil(t, stub.Ambiguous)
+		assert.Equal(t, "aws_instance.web", stub.Ambiguous.TFAddr)
+		require.Len(t, stub.Ambiguous.Candidates, 2)
+		assert.Equal(t, Resource{TFAddr: "aws_instance.web", URN: ambiguousURNs[0]},
+			stub.Ambiguous.Candidates[0])
+		assert.Equal(t, Resource{TFAddr: "aws_instance.web", URN: ambiguousURNs[1]},
+			stub.Ambiguous.Candidates[1])
+		assert.Contains(t, stub.Ambiguous.Error(), "matches 2 resources in the Pulumi stack exactly")
+		assert.Equal(t, ambiguousURNs, stub.PartialMatches)
+
+		assert.Contains(t, FormatUnresolvedStubs("dev", unresolved),
+			"aws_instance.web matches 2 resources")
+	})
+
+	t.Run("skipped and already-resolved entries are untouched", func(t *testing.T) {
+		t.Parallel()
+
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources: []Resource{
+				{TFAddr: "aws_instance.web", URN: "urn:pulumi:dev::proj::custom::web"},
+				{TFAddr: "aws_instance.legacy", Migrate: MigrateModeSkip},
+			},
+		}
+
+		resolved, unresolved := ResolveImportStubs(stack, urns)
+		assert.Equal(t, 0, resolved)
+		assert.Empty(t, unresolved)
+		assert.Equal(t, "urn:pulumi:dev::proj::custom::web", stack.Resources[0].URN)
+	})
+
+	t.Run("a recorded pulumi-name overrides the derived name", func(t *testing.T) {
+		t.Parallel()
+
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources:   []Resource{{TFAddr: "aws_instance.app_server", PulumiName: "web"}},
+		}
+
+		resolved, unresolved := ResolveImportStubs(stack, urns)
+		assert.Equal(t, 1, resolved)
+		assert.Empty(t, unresolved)
+		assert.Equal(t, urns[0], stack.Resources[0].URN)
+	})
+
+	t.Run("pulumi-native entries are left alone", func(t *testing.T) {
+		t.Parallel()
+
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources: []Resource{
+				{Migrate: MigrateModeNew, URN: "urn:pulumi:dev::proj::aws:s3/bucket:Bucket::extra"},
+				{TFAddr: "aws_instance.native", Migrate: MigrateModeNew},
+			},
+		}
+
+		resolved, unresolved := ResolveImportStubs(stack, urns)
+		assert.Equal(t, 0, resolved)
+		assert.Empty(t, unresolved)
+		assert.Equal(t, "", stack.Resources[1].URN)
+	})
+
+	t.Run("a pulumi-type override restricts matching to that type", func(t *testing.T) {
+		t.Parallel()
+
+		typedURNs := []string{
+			"urn:pulumi:dev::proj::aws:ec2/instance:Instance::web",
+			"urn:pulumi:dev::proj::custom:index/machine:Machine::web",
+		}
+
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources: []Resource{{
+				TFAddr:     "aws_instance.web",
+				PulumiType: "custom:index/machine:Machine",
+			}},
+		}
+
+		resolved, unresolved := ResolveImportStubs(stack, typedURNs)
+		assert.Equal(t, 1, resolved)
+		assert.Empty(t, unresolved)
+		assert.Equal(t, typedURNs[1], stack.Resources[0].URN)
+	})
+
+	t.Run("module addresses resolve by the derived name", func(t *testing.T) {
+		t.Parallel()
+
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources:   []Resource{{TFAddr: "module.app.aws_instance.server[0]"}},
+		}
+		moduleURNs := []string{"urn:pulumi:dev::proj::aws:ec2/instance:Instance::app_server"}
+
+		resolved, unresolved := ResolveImportStubs(stack, moduleURNs)
+		assert.Equal(t, 1, resolved)
+		assert.Empty(t, unresolved)
+		assert.Equal(t, moduleURNs[0], stack.Resources[0].URN)
+	})
+}
+
+func Test_TerraformInstanceKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "a", TerraformInstanceKey(`aws_instance.web["a"]`))
+	assert.Equal(t, "0", TerraformInstanceKey(`aws_instance.web[0]`))
+	assert.Equal(t, "us-east-1", TerraformInstanceKey(`module.app.aws_instance.web["us-east-1"]`))
+	assert.Equal(t, "", TerraformInstanceKey("aws_instance.web"))
+}
+
+func Test_ResolveImportStubs_records_instance_key(t *testing.T) {
+	t.Parallel()
+
+	stack := &Stack{
+		PulumiStack: "dev",
+		Resources:   []Resource{{TFAddr: `aws_instance.web["a"]`}},
+	}
+
+	// The name derived from the address strips the key, so the URN carries the bare name.
+	resolved, _ := ResolveImportStubs(stack, []string{"urn:pulumi:dev::proj::aws:ec2/instance:Instance::web"})
+	assert.Equal(t, 1, resolved)
+	assert.Equal(t, "a", stack.Resources[0].Key)
+}
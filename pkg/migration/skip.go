@@ -0,0 +1,149 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+)
+
+// SkipResource marks the resource with the given Terraform address as skipped in the named stack,
+// recording the reason alongside the decision. If the stack has no entry for the address yet one
+// is created, so resources can be skipped before translation has run. An empty stack name applies
+// to every stack in the migration.
+func (mf *MigrationFile) SkipResource(pulumiStack, tfAddr, reason string) error {
+	if tfAddr == "" {
+		return fmt.Errorf("tf-addr is required")
+	}
+
+	matchedStack := false
+	for i := range mf.Migration.Stacks {
+		stack := &mf.Migration.Stacks[i]
+		if pulumiStack != "" && stack.PulumiStack != pulumiStack {
+			continue
+		}
+		matchedStack = true
+		skipInStack(stack, tfAddr, reason)
+	}
+
+	if !matchedStack {
+		return fmt.Errorf("no stack named %q in the migration file", pulumiStack)
+	}
+	return nil
+}
+
+// skipInStack marks the resource with the given address as skipped in a single stack, creating
+// the entry if the address is not tracked yet.
+func skipInStack(stack *Stack, tfAddr, reason string) {
+	for j := range stack.Resources {
+		if stack.Resources[j].TFAddr == tfAddr {
+			stack.Resources[j].Migrate = MigrateModeSkip
+			stack.Resources[j].Reason = reason
+			return
+		}
+	}
+	stack.Resources = append(stack.Resources, Resource{
+		TFAddr:  tfAddr,
+		Migrate: MigrateModeSkip,
+		Reason:  reason,
+	})
+}
+
+// SkipMatchingResources marks every resource whose Terraform address matches the glob pattern as
+// skipped, recording the reason. The pattern is matched against addresses present in each stack's
+// Terraform state as well as addresses already tracked in migration.json, so whole subtrees such
+// as "module.legacy.*" can be skipped in one invocation. An empty stack name applies to every
+// stack. Returns the sorted list of matched addresses across all affected stacks.
+func (mf *MigrationFile) SkipMatchingResources(
+	ctx context.Context, pulumiStack, pattern, reason string,
+) ([]string, error) {
+	matcher, err := compileAddrGlob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	matchedStack := false
+	matchedAddrs := map[string]bool{}
+	for i := range mf.Migration.Stacks {
+		stack := &mf.Migration.Stacks[i]
+		if pulumiStack != "" && stack.PulumiStack != pulumiStack {
+			continue
+		}
+		matchedStack = true
+
+		// Gather candidate addresses from the stack's state and from existing entries.
+		candidates := map[string]bool{}
+		if stack.TFState != "" {
+			state, err := tofu.LoadTerraformState(ctx, tofu.LoadTerraformStateOptions{
+				StateFilePath: stack.TFState,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to load state for stack %s: %w", stack.PulumiStack, err)
+			}
+			err = tofu.VisitResources(state, func(res *tfjson.StateResource) error {
+				candidates[res.Address] = true
+				return nil
+			}, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to visit resources in state for stack %s: %w", stack.PulumiStack, err)
+			}
+		}
+		for _, res := range stack.Resources {
+			if res.TFAddr != "" {
+				candidates[res.TFAddr] = true
+			}
+		}
+
+		for addr := range candidates {
+			if matcher.MatchString(addr) {
+				skipInStack(stack, addr, reason)
+				matchedAddrs[addr] = true
+			}
+		}
+	}
+
+	if !matchedStack {
+		return nil, fmt.Errorf("no stack named %q in the migration file", pulumiStack)
+	}
+
+	var addrs []string
+	for addr := range matchedAddrs {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	return addrs, nil
+}
+
+// compileAddrGlob compiles a glob pattern into a regexp matching whole Terraform addresses.
+// Only "*" is special and matches any run of characters; everything else, including the "[0]"
+// index syntax, is matched literally.
+func compileAddrGlob(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("pattern is required")
+	}
+	quoted := regexp.QuoteMeta(pattern)
+	expr := "^" + strings.ReplaceAll(quoted, `\*`, ".*") + "$"
+	matcher, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address pattern %q: %w", pattern, err)
+	}
+	return matcher, nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkipResource(t *testing.T) {
+	t.Parallel()
+
+	newMigrationFile := func() *MigrationFile {
+		return &MigrationFile{
+			Migration: Migration{
+				Stacks: []Stack{
+					{
+						PulumiStack: "dev",
+						Resources: []Resource{
+							{
+								TFAddr: "aws_instance.web",
+								URN:    "urn:pulumi:dev::proj::aws:ec2/instance:Instance::web",
+							},
+						},
+					},
+					{
+						PulumiStack: "prod",
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("marks an existing entry as skipped with a reason", func(t *testing.T) {
+		t.Parallel()
+
+		mf := newMigrationFile()
+		err := mf.SkipResource("dev", "aws_instance.web", "managed out of band")
+		require.NoError(t, err)
+
+		res := mf.Migration.Stacks[0].Resources[0]
+		assert.Equal(t, MigrateModeSkip, res.Migrate)
+		assert.Equal(t, "managed out of band", res.Reason)
+	})
+
+	t.Run("creates an entry when the resource is not tracked yet", func(t *testing.T) {
+		t.Parallel()
+
+		mf := newMigrationFile()
+		err := mf.SkipResource("prod", "aws_s3_bucket.logs", "")
+		require.NoError(t, err)
+
+		require.Len(t, mf.Migration.Stacks[1].Resources, 1)
+		res := mf.Migration.Stacks[1].Resources[0]
+		assert.Equal(t, "aws_s3_bucket.logs", res.TFAddr)
+		assert.Equal(t, MigrateModeSkip, res.Migrate)
+	})
+
+	t.Run("empty stack name applies to every stack", func(t *testing.T) {
+		t.Parallel()
+
+		mf := newMigrationFile()
+		err := mf.SkipResource("", "aws_instance.web", "skipped everywhere")
+		require.NoError(t, err)
+
+		assert.Equal(t, MigrateModeSkip, mf.Migration.Stacks[0].Resources[0].Migrate)
+		require.Len(t, mf.Migration.Stacks[1].Resources, 1)
+		assert.Equal(t, MigrateModeSkip, mf.Migration.Stacks[1].Resources[0].Migrate)
+	})
+
+	t.Run("errors on unknown stack", func(t *testing.T) {
+		t.Parallel()
+
+		mf := newMigrationFile()
+		err := mf.SkipResource("staging", "aws_instance.web", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no stack named")
+	})
+
+	t.Run("errors on empty address", func(t *testing.T) {
+		t.Parallel()
+
+		mf := newMigrationFile()
+		err := mf.SkipResource("dev", "", "")
+		require.Error(t, err)
+	})
+}
+
+func TestSkipMatchingResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("skips all state resources matching a pattern", func(t *testing.T) {
+		t.Parallel()
+
+		stateFile := writeDiffTestState(t)
+
+		mf := &MigrationFile{
+			Migration: Migration{
+				Stacks: []Stack{
+					{
+						TFState:     stateFile,
+						PulumiStack: "dev",
+					},
+				},
+			},
+		}
+
+		matched, err := mf.SkipMatchingResources(ctx, "dev", "null_resource.*", "legacy")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"null_resource.data", "null_resource.web"}, matched)
+
+		require.Len(t, mf.Migration.Stacks[0].Resources, 2)
+		for _, res := range mf.Migration.Stacks[0].Resources {
+			assert.Equal(t, MigrateModeSkip, res.Migrate)
+			assert.Equal(t, "legacy", res.Reason)
+		}
+	})
+
+	t.Run("matches tracked addresses without state", func(t *testing.T) {
+		t.Parallel()
+
+		mf := &MigrationFile{
+			Migration: Migration{
+				Stacks: []Stack{
+					{
+						PulumiStack: "dev",
+						Resources: []Resource{
+							{TFAddr: "module.legacy.aws_instance.web[0]"},
+							{TFAddr: "aws_instance.keep"},
+						},
+					},
+				},
+			},
+		}
+
+		matched, err := mf.SkipMatchingResources(ctx, "dev", "module.legacy.*", "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"module.legacy.aws_instance.web[0]"}, matched)
+		assert.Equal(t, MigrateModeEmpty, mf.Migration.Stacks[0].Resources[1].Migrate)
+	})
+
+	t.Run("errors on empty pattern", func(t *testing.T) {
+		t.Parallel()
+
+		mf := &MigrationFile{Migration: Migration{Stacks: []Stack{{PulumiStack: "dev"}}}}
+		_, err := mf.SkipMatchingResources(ctx, "dev", "", "")
+		require.Error(t, err)
+	})
+}
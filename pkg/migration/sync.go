@@ -0,0 +1,74 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+// SyncResult summarizes a sync-urns pass over one stack.
+type SyncResult struct {
+	// Confirmed counts entries whose recorded URN exists in the Pulumi stack.
+	Confirmed int `json:"confirmed"`
+
+	// Filled counts entries whose URN was filled in from a unique match in the stack.
+	Filled int `json:"filled"`
+
+	// Stale lists the Terraform addresses whose recorded URN no longer exists in the stack.
+	Stale []string `json:"stale,omitempty"`
+}
+
+// SyncURNs reconciles a stack's entries against the URNs actually present in the imported Pulumi
+// stack. Recorded URNs found in the stack are confirmed; recorded URNs missing from the stack are
+// reported as stale but left in place for review; entries without a URN are filled in when exactly
+// one stack resource matches the name derived from the Terraform address.
+func SyncURNs(stack *Stack, urns []string) SyncResult {
+	present := make(map[string]bool, len(urns))
+	for _, urn := range urns {
+		present[urn] = true
+	}
+
+	var result SyncResult
+	for i := range stack.Resources {
+		res := &stack.Resources[i]
+		if res.TFAddr == "" || res.Migrate == MigrateModeSkip || res.Migrate == MigrateModeNew {
+			continue
+		}
+
+		if res.URN != "" {
+			if present[res.URN] {
+				result.Confirmed++
+			} else {
+				result.Stale = append(result.Stale, res.TFAddr)
+			}
+			continue
+		}
+
+		expectedName := res.PulumiName
+		if expectedName == "" {
+			expectedName = pulumiNameForAddr(res.TFAddr)
+		}
+		var exact []string
+		for _, urn := range urns {
+			if urnName(urn) == expectedName {
+				exact = append(exact, urn)
+			}
+		}
+		if len(exact) == 1 {
+			res.URN = exact[0]
+			if res.Key == "" {
+				res.Key = TerraformInstanceKey(res.TFAddr)
+			}
+			result.Filled++
+		}
+	}
+	return result
+}
@@ -0,0 +1,81 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncURNs(t *testing.T) {
+	t.Parallel()
+
+	urns := []string{
+		"urn:pulumi:dev::proj::aws:ec2/instance:Instance::web",
+		"urn:pulumi:dev::proj::aws:s3/bucket:Bucket::logs",
+	}
+
+	t.Run("confirms recorded URNs and fills in missing ones", func(t *testing.T) {
+		t.Parallel()
+
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources: []Resource{
+				{TFAddr: "aws_instance.web", URN: urns[0]},
+				{TFAddr: "aws_s3_bucket.logs"},
+			},
+		}
+
+		result := SyncURNs(stack, urns)
+		assert.Equal(t, 1, result.Confirmed)
+		assert.Equal(t, 1, result.Filled)
+		assert.Empty(t, result.Stale)
+		assert.Equal(t, urns[1], stack.Resources[1].URN)
+	})
+
+	t.Run("reports stale URNs without clearing them", func(t *testing.T) {
+		t.Parallel()
+
+		stale := "urn:pulumi:dev::proj::aws:ec2/instance:Instance::gone"
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources:   []Resource{{TFAddr: "aws_instance.gone", URN: stale}},
+		}
+
+		result := SyncURNs(stack, urns)
+		assert.Equal(t, 0, result.Confirmed)
+		assert.Equal(t, []string{"aws_instance.gone"}, result.Stale)
+		assert.Equal(t, stale, stack.Resources[0].URN)
+	})
+
+	t.Run("skipped entries and ambiguous names are left alone", func(t *testing.T) {
+		t.Parallel()
+
+		ambiguous := append(urns, "urn:pulumi:dev::proj::aws:lightsail/instance:Instance::web")
+		stack := &Stack{
+			PulumiStack: "dev",
+			Resources: []Resource{
+				{TFAddr: "aws_instance.legacy", Migrate: MigrateModeSkip},
+				{TFAddr: "aws_instance.web"},
+			},
+		}
+
+		result := SyncURNs(stack, ambiguous)
+		assert.Equal(t, 0, result.Confirmed)
+		assert.Equal(t, 0, result.Filled)
+		assert.Empty(t, stack.Resources[1].URN)
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package migtest provides test helpers for round-trip migration testing. Provider repositories
+// can import it to assert that translating a Terraform project's state and importing it into a
+// Pulumi stack produces a clean preview.
+package migtest
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/optpreview"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/stretchr/testify/require"
+)
+
+// AssertCleanImport translates the Terraform state of the project in tfDir into the Pulumi stack
+// in pulumiDir, imports it with 'pulumi stack import', and asserts that a subsequent
+// 'pulumi preview' reports every resource as unchanged.
+//
+// The Terraform project must have been applied (its state is read via the tofu CLI) and pulumiDir
+// must contain a Pulumi program equivalent to the Terraform configuration, with a stack already
+// selected.
+func AssertCleanImport(t testing.TB, tfDir, pulumiDir string) {
+	t.Helper()
+	ctx := context.Background()
+
+	statePath := filepath.Join(pulumiDir, "state.json")
+	err := pkg.TranslateAndWriteState(ctx, tfDir, pulumiDir, statePath, "", false, false)
+	require.NoError(t, err)
+
+	importCmd := exec.Command("pulumi", "stack", "import", "--file", statePath)
+	importCmd.Dir = pulumiDir
+	output, err := importCmd.CombinedOutput()
+	require.NoError(t, err, "pulumi stack import failed: %s", output)
+
+	workspace, err := auto.NewLocalWorkspace(ctx, auto.WorkDir(pulumiDir))
+	require.NoError(t, err)
+
+	err = workspace.Install(ctx, nil)
+	require.NoError(t, err)
+
+	current, err := workspace.Stack(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, current, "no stack selected in %s", pulumiDir)
+
+	stack, err := auto.SelectStack(ctx, current.Name, workspace)
+	require.NoError(t, err)
+
+	result, err := stack.Preview(ctx, optpreview.Diff())
+	require.NoError(t, err)
+
+	t.Logf("pulumi preview --diff:\n%s\n%s", result.StdOut, result.StdErr)
+
+	require.NotEmpty(t, result.ChangeSummary)
+	for op, count := range result.ChangeSummary {
+		require.Equalf(t, apitype.OpType("same"), op,
+			"%d resources have planned operation %q; expected a clean preview", count, op)
+	}
+}
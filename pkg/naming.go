@@ -0,0 +1,127 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NamingStrategy selects how Pulumi resource names are derived from Terraform addresses during
+// translation, so names line up with a team's existing Pulumi naming conventions.
+type NamingStrategy string
+
+const (
+	// NamingStrategyDefault joins the module path and resource name with underscores:
+	// module.app.aws_instance.server becomes "app_server".
+	NamingStrategyDefault NamingStrategy = "default"
+
+	// NamingStrategyStripModules drops the module path and keeps only the resource's own name:
+	// module.app.aws_instance.server becomes "server".
+	NamingStrategyStripModules NamingStrategy = "strip-modules"
+
+	// NamingStrategyKebab joins the module path and resource name with hyphens:
+	// module.app.aws_instance.server becomes "app-server".
+	NamingStrategyKebab NamingStrategy = "kebab"
+)
+
+// namingStrategy and namingFunc are the process-wide naming settings, set by the
+// --naming-strategy flag and by embedding callers respectively.
+var (
+	namingStrategy = NamingStrategyDefault
+	namingFunc     func(address, resourceType string) string
+)
+
+// SetNamingStrategy selects the naming strategy applied to every translated resource.
+func SetNamingStrategy(strategy NamingStrategy) error {
+	switch strategy {
+	case NamingStrategyDefault, NamingStrategyStripModules, NamingStrategyKebab:
+		namingStrategy = strategy
+		return nil
+	}
+	return fmt.Errorf("unknown naming strategy %q: expected %q, %q or %q",
+		strategy, NamingStrategyDefault, NamingStrategyStripModules, NamingStrategyKebab)
+}
+
+// SetNamingFunc installs a user naming function consulted before the configured strategy;
+// returning "" passes the resource on to the strategy. Meant for callers embedding this package —
+// the CLI only exposes the named strategies.
+func SetNamingFunc(f func(address, resourceType string) string) {
+	namingFunc = f
+}
+
+// splitTerraformAddress breaks a Terraform address into the module path and the name parts
+// following the resource type, e.g. module.app.aws_instance.server yields ["app"] and ["server"].
+func splitTerraformAddress(address, resourceType string) (moduleParts, nameParts []string) {
+	parts := strings.Split(address, ".")
+	for i := 0; i < len(parts); i++ {
+		if parts[i] == resourceType {
+			nameParts = append(nameParts, parts[i+1:]...)
+			break
+		}
+		if parts[i] == "module" && i+1 < len(parts) {
+			moduleParts = append(moduleParts, parts[i+1])
+			i++
+		}
+	}
+	return moduleParts, nameParts
+}
+
+// applyNamingStrategy derives the Pulumi name for a Terraform address under the configured
+// naming settings.
+func applyNamingStrategy(address, resourceType string) string {
+	if namingFunc != nil {
+		if name := namingFunc(address, resourceType); name != "" {
+			return name
+		}
+	}
+
+	moduleParts, nameParts := splitTerraformAddress(address, resourceType)
+	switch namingStrategy {
+	case NamingStrategyStripModules:
+		return strings.Join(nameParts, "_")
+	case NamingStrategyKebab:
+		return strings.Join(append(moduleParts, nameParts...), "-")
+	default:
+		return strings.Join(append(moduleParts, nameParts...), "_")
+	}
+}
+
+// uniqueNamer guarantees name uniqueness within a resource type regardless of the configured
+// strategy. Strategies like strip-modules can map distinct addresses to the same name; the first
+// resource keeps its derived name and later collisions get a numeric suffix.
+type uniqueNamer struct {
+	used map[string]bool
+}
+
+func newUniqueNamer() *uniqueNamer {
+	return &uniqueNamer{used: map[string]bool{}}
+}
+
+// unique claims name for resourceType, appending "-2", "-3", ... when the name is already taken.
+func (n *uniqueNamer) unique(resourceType, name string) string {
+	key := resourceType + "::" + name
+	if !n.used[key] {
+		n.used[key] = true
+		return name
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s-%d", name, i)
+		if !n.used[resourceType+"::"+candidate] {
+			n.used[resourceType+"::"+candidate] = true
+			return candidate
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamingStrategies(t *testing.T) {
+	// Mutates the package-level naming settings, so no t.Parallel.
+	defer func() {
+		require.NoError(t, SetNamingStrategy(NamingStrategyDefault))
+		SetNamingFunc(nil)
+	}()
+
+	addr := "module.app.aws_instance.server"
+
+	require.NoError(t, SetNamingStrategy(NamingStrategyDefault))
+	assert.Equal(t, "app_server", PulumiNameFromTerraformAddress(addr, "aws_instance"))
+
+	require.NoError(t, SetNamingStrategy(NamingStrategyStripModules))
+	assert.Equal(t, "server", PulumiNameFromTerraformAddress(addr, "aws_instance"))
+
+	require.NoError(t, SetNamingStrategy(NamingStrategyKebab))
+	assert.Equal(t, "app-server", PulumiNameFromTerraformAddress(addr, "aws_instance"))
+
+	require.ErrorContains(t, SetNamingStrategy("camel"), "unknown naming strategy")
+
+	// A user function wins when it has an opinion and falls through when it returns "".
+	require.NoError(t, SetNamingStrategy(NamingStrategyDefault))
+	SetNamingFunc(func(address, resourceType string) string {
+		if resourceType == "aws_instance" {
+			return "custom"
+		}
+		return ""
+	})
+	assert.Equal(t, "custom", PulumiNameFromTerraformAddress(addr, "aws_instance"))
+	assert.Equal(t, "logs", PulumiNameFromTerraformAddress("aws_s3_bucket.logs", "aws_s3_bucket"))
+}
+
+func Test_uniqueNamer(t *testing.T) {
+	t.Parallel()
+
+	names := newUniqueNamer()
+	assert.Equal(t, "server", names.unique("aws:ec2/instance:Instance", "server"))
+	assert.Equal(t, "server-2", names.unique("aws:ec2/instance:Instance", "server"))
+	assert.Equal(t, "server-3", names.unique("aws:ec2/instance:Instance", "server"))
+	// The same name under a different type is not a collision.
+	assert.Equal(t, "server", names.unique("aws:lightsail/instance:Instance", "server"))
+}
@@ -0,0 +1,67 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge/info"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/bridgedproviders"
+)
+
+// providerSchemaDir is the process-wide offline schema directory set by the
+// --provider-schema-dir flag.
+var providerSchemaDir string
+
+// SetProviderSchemaDir points translation at a directory of pre-exported provider mappings for
+// air-gapped use. Files are named <provider>.json (e.g. aws.json) and hold the provider's
+// Terraform mapping data, exported with `pulumi package get-mapping terraform <provider>`.
+// Providers found in the directory are loaded from it instead of installing the provider binary;
+// providers without a file fall back to the regular install path.
+func SetProviderSchemaDir(dir string) {
+	providerSchemaDir = dir
+}
+
+// loadOfflineProviderMapping loads the mapping of the named provider from the configured schema
+// directory. Returns (nil, nil) when no directory is configured or the provider has no file
+// there, so callers fall through to installing the provider.
+func loadOfflineProviderMapping(name string) (*info.Provider, error) {
+	if providerSchemaDir == "" {
+		return nil, nil
+	}
+
+	path := filepath.Join(providerSchemaDir, name+".json")
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read offline provider schema %s: %w", path, err)
+	}
+
+	providerInfo, err := bridgedproviders.UnmarshalMappingData(&bridgedproviders.GetMappingResult{
+		Provider: name,
+		Data:     data,
+		Key:      "terraform",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse offline provider schema %s: %w", path, err)
+	}
+	return providerInfo, nil
+}
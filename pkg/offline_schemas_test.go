@@ -0,0 +1,56 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_loadOfflineProviderMapping(t *testing.T) {
+	// Mutates the package-level schema dir, so no t.Parallel.
+	dir := t.TempDir()
+	SetProviderSchemaDir(dir)
+	defer SetProviderSchemaDir("")
+
+	mapping := `{"name": "random", "resources": {"random_string": {"tok": "random:index/randomString:RandomString"}}}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "random.json"), []byte(mapping), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("not json"), 0o644))
+
+	info, err := loadOfflineProviderMapping("random")
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	assert.Equal(t, "random", info.Name)
+	require.Contains(t, info.Resources, "random_string")
+
+	// Providers without a file fall through to the install path.
+	info, err = loadOfflineProviderMapping("aws")
+	require.NoError(t, err)
+	assert.Nil(t, info)
+
+	_, err = loadOfflineProviderMapping("broken")
+	require.ErrorContains(t, err, "failed to parse offline provider schema")
+}
+
+func Test_loadOfflineProviderMapping_disabled(t *testing.T) {
+	// No schema dir configured: everything falls through to the install path.
+	info, err := loadOfflineProviderMapping("aws")
+	require.NoError(t, err)
+	assert.Nil(t, info)
+}
@@ -0,0 +1,73 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// pluginsYAMLFilePath is the process-wide plugins snippet file set by the --plugins-yaml flag.
+var pluginsYAMLFilePath string
+
+// SetPluginsYAMLFile sets the path of a file to write a Pulumi.yaml plugins block to after
+// translation. The snippet declares the required providers at the versions matching the
+// translated state, ready to paste into (or append to) the project's Pulumi.yaml, saving the
+// manual step of declaring them one by one.
+func SetPluginsYAMLFile(path string) {
+	pluginsYAMLFilePath = path
+}
+
+// pluginPins holds per-provider version overrides set by the --pin flag, keyed by provider name.
+var pluginPins map[string]string
+
+// SetPluginPins overrides the versions of named providers in the emitted plugins block, for teams
+// that standardize on specific provider releases regardless of what the state was written with.
+func SetPluginPins(pins map[string]string) {
+	pluginPins = pins
+}
+
+// FormatPluginsYAML renders the required providers as a Pulumi.yaml plugins block. Providers are
+// listed in the given order, which dedupeRequiredProviders has already sorted by name; --pin
+// overrides replace individual versions.
+func FormatPluginsYAML(providers []*ProviderWithMetadata) string {
+	var sb strings.Builder
+	sb.WriteString("plugins:\n")
+	sb.WriteString("  providers:\n")
+	for _, provider := range providers {
+		name := requiredProviderName(provider)
+		version := provider.Version
+		if pinned, ok := pluginPins[name]; ok {
+			version = pinned
+		}
+		fmt.Fprintf(&sb, "    - name: %s\n", name)
+		fmt.Fprintf(&sb, "      version: %s\n", strings.TrimPrefix(version, "v"))
+	}
+	return sb.String()
+}
+
+// writePluginsYAML writes the plugins block for the translated state's providers to the
+// configured file; a no-op unless --plugins-yaml was given.
+func writePluginsYAML(providers []*ProviderWithMetadata) error {
+	if pluginsYAMLFilePath == "" {
+		return nil
+	}
+	snippet := FormatPluginsYAML(providers)
+	if err := os.WriteFile(pluginsYAMLFilePath, []byte(snippet), 0o600); err != nil {
+		return fmt.Errorf("failed to write plugins YAML: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge/info"
+	"github.com/stretchr/testify/require"
+)
+
+// Not parallel: mutates the package-level plugin pin and plugins-yaml settings.
+func Test_FormatPluginsYAML(t *testing.T) {
+	providers := []*ProviderWithMetadata{
+		{Provider: &info.Provider{Name: "aws", Version: "7.12.0"}},
+		{Provider: &info.Provider{Name: "random", Version: "v4.16.3"}},
+	}
+
+	require.Equal(t, `plugins:
+  providers:
+    - name: aws
+      version: 7.12.0
+    - name: random
+      version: 4.16.3
+`, FormatPluginsYAML(providers))
+
+	t.Run("pins override individual versions", func(t *testing.T) {
+		SetPluginPins(map[string]string{"aws": "7.0.0"})
+		defer SetPluginPins(nil)
+
+		snippet := FormatPluginsYAML(providers)
+		require.Contains(t, snippet, "version: 7.0.0")
+		require.Contains(t, snippet, "version: 4.16.3")
+	})
+}
+
+func Test_writePluginsYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plugins.yaml")
+	SetPluginsYAMLFile(path)
+	defer SetPluginsYAMLFile("")
+
+	require.NoError(t, writePluginsYAML([]*ProviderWithMetadata{
+		{Provider: &info.Provider{Name: "tls", Version: "5.2.0"}},
+	}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "name: tls")
+}
+
+func Test_writePluginsYAML_disabled(t *testing.T) {
+	require.NoError(t, writePluginsYAML(nil))
+}
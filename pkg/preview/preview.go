@@ -0,0 +1,106 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package preview interprets Pulumi preview results to judge migration health. A resource whose
+// preview step is a no-op migrated cleanly; updates and replacements indicate the translated state
+// does not fully match what the Pulumi program would provision.
+package preview
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/events"
+	"github.com/pulumi/pulumi/sdk/v3/go/auto/optpreview"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// Options configures a migration-health preview.
+type Options struct {
+	// Stack selects the Pulumi stack to preview, such as "dev".
+	Stack string
+
+	// NoRefresh runs the preview without refresh semantics. This avoids reading live cloud state,
+	// trading speed and offline operability for accuracy: needs-update/needs-replace
+	// classification may miss drift that only a refresh would reveal.
+	NoRefresh bool
+}
+
+// Preview runs `pulumi preview` on the program and classifies the planned operation for every
+// resource, keyed by URN. Operations with no bearing on migration status (reads, refreshes and
+// similar bookkeeping steps) are omitted.
+func Preview(ctx context.Context, pulumiProgramDir string, opts Options) (map[string]migration.ResourceStatus, error) {
+	workspace, err := auto.NewLocalWorkspace(ctx, auto.WorkDir(pulumiProgramDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workspace: %w", err)
+	}
+
+	stack, err := auto.SelectStack(ctx, opts.Stack, workspace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select stack %s: %w", opts.Stack, err)
+	}
+
+	eventChannel := make(chan events.EngineEvent)
+	statuses := map[string]migration.ResourceStatus{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for event := range eventChannel {
+			if event.ResourcePreEvent == nil {
+				continue
+			}
+			metadata := event.ResourcePreEvent.Metadata
+			if status, ok := classifyOperation(metadata.Op); ok {
+				statuses[metadata.URN] = status
+			}
+		}
+	}()
+
+	previewOpts := []optpreview.Option{optpreview.EventStreams(eventChannel)}
+	if !opts.NoRefresh {
+		previewOpts = append(previewOpts, optpreview.Refresh())
+	}
+	_, err = stack.Preview(ctx, previewOpts...)
+	<-done
+	if err != nil {
+		return nil, fmt.Errorf("pulumi preview failed: %w", err)
+	}
+
+	return statuses, nil
+}
+
+// classifyOperation maps a planned preview operation to the migration status it implies for the
+// corresponding resource. Operations that don't bear on migration health — reads, refreshes,
+// imports and other bookkeeping steps a preview may emit — return ok=false so callers skip them
+// instead of aborting.
+func classifyOperation(op apitype.OpType) (migration.ResourceStatus, bool) {
+	switch op {
+	case apitype.OpSame:
+		return migration.ResourceStatusTracked, true
+	case apitype.OpUpdate:
+		return migration.ResourceStatusNeedsUpdate, true
+	case apitype.OpReplace, apitype.OpCreateReplacement, apitype.OpDeleteReplaced:
+		return migration.ResourceStatusNeedsReplace, true
+	case apitype.OpCreate:
+		// The resource exists in the program but carried no translated state.
+		return migration.ResourceStatusNotTranslated, true
+	default:
+		slog.Debug("Skipping operation with no bearing on migration status",
+			slog.Any("op", string(op)))
+		return "", false
+	}
+}
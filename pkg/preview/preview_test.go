@@ -0,0 +1,56 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preview
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_classifyOperation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		op     apitype.OpType
+		status migration.ResourceStatus
+		ok     bool
+	}{
+		{apitype.OpSame, migration.ResourceStatusTracked, true},
+		{apitype.OpUpdate, migration.ResourceStatusNeedsUpdate, true},
+		{apitype.OpReplace, migration.ResourceStatusNeedsReplace, true},
+		{apitype.OpCreateReplacement, migration.ResourceStatusNeedsReplace, true},
+		{apitype.OpDeleteReplaced, migration.ResourceStatusNeedsReplace, true},
+		{apitype.OpCreate, migration.ResourceStatusNotTranslated, true},
+
+		// Operations a preview can emit that must be skipped, not crash the tool.
+		{apitype.OpRefresh, "", false},
+		{apitype.OpRead, "", false},
+		{apitype.OpImport, "", false},
+		{apitype.OpDelete, "", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.op), func(t *testing.T) {
+			t.Parallel()
+
+			status, ok := classifyOperation(tc.op)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.status, status)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/providermap"
+)
+
+// ProviderVersionIssue describes a Terraform provider whose version in the state is newer than the
+// upstream version the recommended bridged Pulumi provider is built against. Attributes introduced
+// after the bridged version may be dropped during translation or fail to unmarshal against the
+// older schema.
+type ProviderVersionIssue struct {
+	// Terraform provider address, such as "registry.terraform.io/hashicorp/aws".
+	TerraformProvider string
+
+	// Provider version recorded in the Terraform state.
+	StateVersion string
+
+	// Upstream Terraform provider version the recommended bridged Pulumi provider is built against.
+	UpstreamVersion string
+}
+
+func (i ProviderVersionIssue) String() string {
+	return fmt.Sprintf(
+		"Terraform provider %s version %s in the state is newer than version %s bridged by the "+
+			"recommended Pulumi provider; attributes introduced after %s may be dropped or fail to "+
+			"unmarshal during translation",
+		i.TerraformProvider, i.StateVersion, i.UpstreamVersion, i.UpstreamVersion)
+}
+
+// CheckProviderVersionCompatibility compares, for every Terraform provider used in the state, the
+// provider version recorded in the state against the upstream version of the recommended bridged
+// Pulumi provider. Providers without a known version, without a statically bridged provider
+// (dynamic bridging loads the exact version from the registry), or without version-map data are
+// skipped. Issues are returned sorted by provider address.
+func CheckProviderVersionCompatibility(
+	tfState *tfjson.State, providerVersions map[string]string,
+) ([]ProviderVersionIssue, error) {
+	terraformProviders, err := getTerraformProvidersForTerraformState(tfState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get terraform providers: %w", err)
+	}
+
+	var issues []ProviderVersionIssue
+	for _, providerName := range terraformProviders {
+		stateVersion := providerVersions[string(providerName)]
+		if stateVersion == "" {
+			continue
+		}
+		stateSemver, err := semver.Parse(strings.TrimPrefix(stateVersion, "v"))
+		if err != nil {
+			continue
+		}
+
+		recommended := providermap.RecommendPulumiProvider(providermap.TerraformProvider{
+			Identifier: providerName,
+			Version:    stateVersion,
+		})
+		if recommended.UseDynamicBridging || recommended.StaticallyBridgedProvider == nil {
+			continue
+		}
+
+		upstream, ok := providermap.GetUpstreamVersion(providerName, recommended.StaticallyBridgedProvider.Version)
+		if !ok {
+			continue
+		}
+		upstreamSemver, err := semver.Parse(strings.TrimPrefix(upstream, "v"))
+		if err != nil {
+			continue
+		}
+
+		if stateSemver.GT(upstreamSemver) {
+			issues = append(issues, ProviderVersionIssue{
+				TerraformProvider: string(providerName),
+				StateVersion:      stateVersion,
+				UpstreamVersion:   upstream,
+			})
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].TerraformProvider < issues[j].TerraformProvider
+	})
+	return issues, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/providermap"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckProviderVersionCompatibility(t *testing.T) {
+	t.Parallel()
+
+	const awsProvider = "registry.terraform.io/hashicorp/aws"
+
+	state := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{
+					{
+						Address:      "aws_instance.web",
+						Type:         "aws_instance",
+						Name:         "web",
+						ProviderName: awsProvider,
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("flags state versions newer than the bridged upstream", func(t *testing.T) {
+		t.Parallel()
+
+		issues, err := CheckProviderVersionCompatibility(state, map[string]string{
+			awsProvider: "999.0.0",
+		})
+		require.NoError(t, err)
+		require.Len(t, issues, 1)
+		assert.Equal(t, awsProvider, issues[0].TerraformProvider)
+		assert.Equal(t, "999.0.0", issues[0].StateVersion)
+		assert.NotEmpty(t, issues[0].UpstreamVersion)
+		assert.Contains(t, issues[0].String(), "newer than")
+	})
+
+	t.Run("no issue when the bridged upstream matches the state version", func(t *testing.T) {
+		t.Parallel()
+
+		upstream, ok := providermap.GetUpstreamVersion(awsProvider, "")
+		require.True(t, ok)
+
+		issues, err := CheckProviderVersionCompatibility(state, map[string]string{
+			awsProvider: upstream,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, issues)
+	})
+
+	t.Run("providers without a recorded version are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		issues, err := CheckProviderVersionCompatibility(state, nil)
+		require.NoError(t, err)
+		assert.Empty(t, issues)
+	})
+}
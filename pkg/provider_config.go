@@ -0,0 +1,150 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ExtractProviderConfigs parses the provider blocks in the Terraform root module and returns their
+// configuration keyed by provider local name, such as "aws". Attribute values are evaluated with
+// the project's input variables resolved from variable defaults and any tfvars files present, so
+// common patterns like 'region = var.region' produce concrete values. Attributes that still cannot
+// be evaluated statically are skipped with a warning. Nested blocks such as assume_role become
+// object values.
+func ExtractProviderConfigs(tfDir string) (map[string]resource.PropertyMap, error) {
+	entries, err := os.ReadDir(tfDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Terraform directory: %w", err)
+	}
+
+	variables, err := loadTerraformVariables(tfDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to resolve Terraform variables: %v\n", err)
+		variables = nil
+	}
+	evalCtx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{"var": cty.ObjectVal(variables)},
+	}
+
+	parser := hclparse.NewParser()
+	configs := map[string]resource.PropertyMap{}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".tf") {
+			continue
+		}
+		file, diags := parser.ParseHCLFile(filepath.Join(tfDir, entry.Name()))
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("failed to parse %s: %w", entry.Name(), diags)
+		}
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type != "provider" || len(block.Labels) != 1 {
+				continue
+			}
+			config := providerBodyToPropertyMap(block.Body, evalCtx, block.Labels[0])
+			if len(config) == 0 {
+				continue
+			}
+			configs[block.Labels[0]] = config
+		}
+	}
+
+	return configs, nil
+}
+
+// providerBodyToPropertyMap converts the body of a provider block into a property map, evaluating
+// attributes against the resolved input variables and recursing into nested blocks. Attributes that
+// still reference dynamic context (unset variables, data sources, functions of unknowns) are
+// skipped with a warning. The "alias" meta-argument is omitted since it has no Pulumi counterpart.
+func providerBodyToPropertyMap(body *hclsyntax.Body, evalCtx *hcl.EvalContext, providerName string) resource.PropertyMap {
+	result := resource.PropertyMap{}
+
+	for name, attr := range body.Attributes {
+		if name == "alias" {
+			continue
+		}
+		value, diags := attr.Expr.Value(evalCtx)
+		if diags.HasErrors() || !value.IsWhollyKnown() {
+			fmt.Fprintf(os.Stderr, "Warning: skipping provider %s attribute %s: "+
+				"its value cannot be resolved statically\n", providerName, name)
+			continue
+		}
+		result[resource.PropertyKey(name)] = ctyValueToProperty(value)
+	}
+
+	for _, block := range body.Blocks {
+		nested := providerBodyToPropertyMap(block.Body, evalCtx, providerName)
+		if len(nested) == 0 {
+			continue
+		}
+		result[resource.PropertyKey(block.Type)] = resource.NewObjectProperty(nested)
+	}
+
+	return result
+}
+
+// ctyValueToProperty converts a statically known cty value into a Pulumi property value.
+func ctyValueToProperty(value cty.Value) resource.PropertyValue {
+	if value.IsNull() || !value.IsKnown() {
+		return resource.NewNullProperty()
+	}
+
+	ty := value.Type()
+	switch {
+	case ty == cty.String:
+		return resource.NewStringProperty(value.AsString())
+	case ty == cty.Bool:
+		return resource.NewBoolProperty(value.True())
+	case ty == cty.Number:
+		num, _ := value.AsBigFloat().Float64()
+		return resource.NewNumberProperty(num)
+	case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+		var elements []resource.PropertyValue
+		for _, element := range value.AsValueSlice() {
+			elements = append(elements, ctyValueToProperty(element))
+		}
+		return resource.NewArrayProperty(elements)
+	case ty.IsMapType() || ty.IsObjectType():
+		object := resource.PropertyMap{}
+		for key, element := range value.AsValueMap() {
+			object[resource.PropertyKey(key)] = ctyValueToProperty(element)
+		}
+		return resource.NewObjectProperty(object)
+	default:
+		return resource.NewNullProperty()
+	}
+}
+
+// terraformProviderLocalName returns the local name of a Terraform provider from its full address,
+// e.g. "aws" for "registry.terraform.io/hashicorp/aws". Provider blocks in Terraform configuration
+// are keyed by local name.
+func terraformProviderLocalName(providerAddr string) string {
+	parts := strings.Split(providerAddr, "/")
+	return parts[len(parts)-1]
+}
@@ -0,0 +1,68 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// providerConfigFilePath is the process-wide provider-config dump file set by the
+// --include-provider-config flag.
+var providerConfigFilePath string
+
+// SetProviderConfigFile sets the path of a file to write the synthesized pulumi:providers:*
+// inputs and outputs to during translation. Imported resources that show diffs often do so
+// because of provider config differences (region, assume_role); the dump makes the config the
+// imported provider resource will carry inspectable without digging through the full state file.
+func SetProviderConfigFile(path string) {
+	providerConfigFilePath = path
+}
+
+// providerConfigDumpEntry is one synthesized pulumi:providers:* resource in the dump.
+type providerConfigDumpEntry struct {
+	Type    string                 `json:"type"`
+	Name    string                 `json:"name"`
+	Inputs  map[string]interface{} `json:"inputs"`
+	Outputs map[string]interface{} `json:"outputs"`
+}
+
+// writeProviderConfigDump writes the translated state's provider resources to the configured
+// dump file as JSON. No-op when no file is configured.
+func writeProviderConfigDump(state *PulumiState) error {
+	if providerConfigFilePath == "" {
+		return nil
+	}
+
+	entries := make([]providerConfigDumpEntry, 0, len(state.Providers))
+	for _, provider := range state.Providers {
+		entries = append(entries, providerConfigDumpEntry{
+			Type:    provider.Type,
+			Name:    provider.Name,
+			Inputs:  provider.Inputs.Mappable(),
+			Outputs: provider.Outputs.Mappable(),
+		})
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(providerConfigFilePath, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write provider config dump: %w", err)
+	}
+	return nil
+}
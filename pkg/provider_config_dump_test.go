@@ -0,0 +1,66 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_writeProviderConfigDump(t *testing.T) {
+	// Mutates the package-level dump path, so no t.Parallel.
+	dumpPath := filepath.Join(t.TempDir(), "providers.json")
+	SetProviderConfigFile(dumpPath)
+	defer SetProviderConfigFile("")
+
+	inputs := resource.PropertyMap{
+		"region": resource.NewStringProperty("us-west-2"),
+	}
+	state := &PulumiState{
+		Providers: []PulumiResource{{
+			PulumiResourceID: PulumiResourceID{
+				ID:   "provider-id",
+				Type: "pulumi:providers:aws",
+				Name: "default_7_12_0",
+			},
+			Inputs:  inputs,
+			Outputs: inputs,
+		}},
+	}
+
+	require.NoError(t, writeProviderConfigDump(state))
+
+	data, err := os.ReadFile(dumpPath)
+	require.NoError(t, err)
+
+	var entries []providerConfigDumpEntry
+	require.NoError(t, json.Unmarshal(data, &entries))
+	require.Len(t, entries, 1)
+	assert.Equal(t, "pulumi:providers:aws", entries[0].Type)
+	assert.Equal(t, "default_7_12_0", entries[0].Name)
+	assert.Equal(t, "us-west-2", entries[0].Inputs["region"])
+	assert.Equal(t, "us-west-2", entries[0].Outputs["region"])
+}
+
+func Test_writeProviderConfigDump_disabled(t *testing.T) {
+	// No dump file configured: nothing is written.
+	require.NoError(t, writeProviderConfigDump(&PulumiState{}))
+}
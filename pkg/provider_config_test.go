@@ -0,0 +1,159 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractProviderConfigs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	mainTF := `
+provider "aws" {
+  region                      = "eu-west-1"
+  skip_credentials_validation = true
+  max_retries                 = 3
+
+  assume_role {
+    role_arn = "arn:aws:iam::123456789012:role/migration"
+  }
+}
+
+provider "random" {}
+
+variable "project" {
+  type = string
+}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(mainTF), 0o600))
+
+	configs, err := ExtractProviderConfigs(dir)
+	require.NoError(t, err)
+
+	// The empty random provider block contributes no configuration.
+	require.Len(t, configs, 1)
+
+	aws := configs["aws"]
+	require.NotNil(t, aws)
+	require.Equal(t, "eu-west-1", aws["region"].StringValue())
+	require.Equal(t, true, aws["skip_credentials_validation"].BoolValue())
+	require.Equal(t, float64(3), aws["max_retries"].NumberValue())
+
+	assumeRole := aws["assume_role"].ObjectValue()
+	require.Equal(t, "arn:aws:iam::123456789012:role/migration", assumeRole["role_arn"].StringValue())
+}
+
+func TestExtractProviderConfigs_skips_dynamic_attributes(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	mainTF := `
+provider "aws" {
+  region  = var.region
+  profile = "migration"
+}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(mainTF), 0o600))
+
+	configs, err := ExtractProviderConfigs(dir)
+	require.NoError(t, err)
+
+	aws := configs["aws"]
+	require.NotNil(t, aws)
+	_, hasRegion := aws["region"]
+	require.False(t, hasRegion, "attributes referencing variables should be skipped")
+	require.Equal(t, "migration", aws["profile"].StringValue())
+}
+
+func TestExtractProviderConfigs_resolves_variables(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	mainTF := `
+variable "region" {
+  type    = string
+  default = "us-east-1"
+}
+
+variable "profile" {
+  type = string
+}
+
+provider "aws" {
+  region  = var.region
+  profile = var.profile
+}
+`
+	tfvars := `
+region  = "eu-central-1"
+profile = "migration"
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(mainTF), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "terraform.tfvars"), []byte(tfvars), 0o600))
+
+	configs, err := ExtractProviderConfigs(dir)
+	require.NoError(t, err)
+
+	aws := configs["aws"]
+	require.NotNil(t, aws)
+	// terraform.tfvars overrides the variable default.
+	require.Equal(t, "eu-central-1", aws["region"].StringValue())
+	require.Equal(t, "migration", aws["profile"].StringValue())
+}
+
+func TestExtractProviderConfigs_resolves_variable_defaults(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	mainTF := `
+variable "region" {
+  type    = string
+  default = "us-west-2"
+}
+
+provider "aws" {
+  region = var.region
+}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte(mainTF), 0o600))
+
+	configs, err := ExtractProviderConfigs(dir)
+	require.NoError(t, err)
+
+	require.Equal(t, "us-west-2", configs["aws"]["region"].StringValue())
+}
+
+func TestGetProviderInputs_carries_provider_config(t *testing.T) {
+	t.Parallel()
+
+	config := resource.PropertyMap{
+		"region": resource.NewStringProperty("eu-west-1"),
+	}
+
+	inputs, err := GetProviderInputs("aws", config)
+	require.NoError(t, err)
+	require.Equal(t, "eu-west-1", inputs["region"].StringValue())
+
+	empty, err := GetProviderInputs("aws", nil)
+	require.NoError(t, err)
+	require.Empty(t, empty)
+}
@@ -0,0 +1,66 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/blang/semver/v4"
+)
+
+// minSupportedProviderVersions records, per Pulumi provider name, the oldest major version line
+// that still receives fixes. Versions below the threshold are end-of-life: the migration still
+// runs, but imported resources may hit schema bugs the provider will never fix, so users should
+// upgrade the provider in their Terraform project first and re-run the migration.
+var minSupportedProviderVersions = map[string]string{
+	"aws":     "6.0.0",
+	"azure":   "6.0.0",
+	"gcp":     "8.0.0",
+	"azuread": "6.0.0",
+}
+
+// eolProviderWarning returns an actionable warning when the resolved provider version is below the
+// supported threshold, or "" when the version is supported, unknown to the table, or unparseable.
+func eolProviderWarning(provider *ProviderWithMetadata) string {
+	minVersion, ok := minSupportedProviderVersions[requiredProviderName(provider)]
+	if !ok {
+		return ""
+	}
+	resolved, err := semver.ParseTolerant(provider.Version)
+	if err != nil {
+		return ""
+	}
+	threshold, err := semver.ParseTolerant(minVersion)
+	if err != nil {
+		return ""
+	}
+	if resolved.GE(threshold) {
+		return ""
+	}
+	return fmt.Sprintf("provider %s %s is end-of-life (oldest supported version is %s); "+
+		"upgrade the provider in your Terraform project and re-run the migration to avoid "+
+		"translating against an unmaintained schema", requiredProviderName(provider), provider.Version, minVersion)
+}
+
+// warnEOLProviderVersions prints a warning to stderr for every required provider resolved at an
+// end-of-life version.
+func warnEOLProviderVersions(providers []*ProviderWithMetadata) {
+	for _, provider := range providers {
+		if warning := eolProviderWarning(provider); warning != "" {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge/info"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_eolProviderWarning(t *testing.T) {
+	t.Parallel()
+
+	provider := func(name, version string) *ProviderWithMetadata {
+		return &ProviderWithMetadata{Provider: &info.Provider{Name: name, Version: version}}
+	}
+
+	t.Run("warns below the supported threshold", func(t *testing.T) {
+		t.Parallel()
+		warning := eolProviderWarning(provider("aws", "5.43.0"))
+		assert.Contains(t, warning, "aws 5.43.0 is end-of-life")
+		assert.Contains(t, warning, "oldest supported version is 6.0.0")
+	})
+
+	t.Run("silent at or above the threshold", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, eolProviderWarning(provider("aws", "6.0.0")))
+		assert.Empty(t, eolProviderWarning(provider("aws", "7.12.0")))
+	})
+
+	t.Run("silent for providers not in the table", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, eolProviderWarning(provider("random", "1.0.0")))
+	})
+
+	t.Run("silent for unparseable versions", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, eolProviderWarning(provider("aws", "main")))
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge/info"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/bridge"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/providermap"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+)
+
+// ProviderPlanEntry describes how one Terraform resource is expected to migrate: which Pulumi
+// provider will serve it, the Pulumi type token it is expected to map to, and whether an import ID
+// can be inferred from its state attributes.
+type ProviderPlanEntry struct {
+	// Terraform resource address such as "aws_instance.app_server".
+	TFAddr string `json:"tf-addr"`
+
+	// Full Terraform provider name such as "registry.terraform.io/hashicorp/aws".
+	TerraformProvider string `json:"terraform-provider"`
+
+	// Name of the resolved Pulumi provider, or "terraform-provider" for dynamically bridged ones.
+	PulumiProvider string `json:"pulumi-provider"`
+
+	// Whether the resource will be served through dynamic bridging.
+	Dynamic bool `json:"dynamic,omitempty"`
+
+	// Expected Pulumi type token, derived from the bridge's default naming. Overridden tokens in
+	// the actual provider may differ; empty when no token could be derived.
+	PulumiType string `json:"pulumi-type,omitempty"`
+
+	// Import ID inferred from the resource's state attributes; empty when inference failed.
+	ImportID string `json:"import-id,omitempty"`
+}
+
+// ProviderPlan computes a dry-run migration plan for every managed resource in a Terraform state
+// without installing any providers: the Pulumi provider is resolved through the static provider
+// map, the type token through the bridge's default naming, and the import ID through the standard
+// inference strategies. The result follows the state's resource order.
+func ProviderPlan(tfState *tfjson.State) ([]ProviderPlanEntry, error) {
+	inferrer := NewImportIDInferrer(AzureImportIDStrategy())
+
+	var entries []ProviderPlanEntry
+	err := tofu.VisitResources(tfState, func(res *tfjson.StateResource) error {
+		entry := ProviderPlanEntry{
+			TFAddr:            res.Address,
+			TerraformProvider: res.ProviderName,
+		}
+
+		recommended := providermap.RecommendPulumiProvider(providermap.TerraformProvider{
+			Identifier: providermap.TerraformProviderName(res.ProviderName),
+		})
+		if recommended.UseDynamicBridging {
+			entry.PulumiProvider = "terraform-provider"
+			entry.Dynamic = true
+		} else {
+			entry.PulumiProvider = recommended.StaticallyBridgedProvider.Identifier
+			entry.PulumiType = defaultPulumiTypeToken(res.Type, res.ProviderName, entry.PulumiProvider)
+		}
+
+		if id, err := inferrer.InferImportID(res); err == nil {
+			entry.ImportID = id
+		}
+
+		entries = append(entries, entry)
+		return nil
+	}, nil) // Use default options (skips data sources)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// defaultPulumiTypeToken derives the Pulumi type token for a Terraform resource type through the
+// bridge's default naming, without loading any provider. The default derivation requires the
+// resource type to carry the upstream provider's prefix; overridden tokens are not visible without
+// loading the provider, so the result may differ from the actual token and is empty when no token
+// could be derived.
+func defaultPulumiTypeToken(resType, tfProviderName, pulumiProviderName string) string {
+	upstream, ok := providermap.UpstreamProviderName(providermap.TerraformProviderName(tfProviderName))
+	if !ok || !strings.HasPrefix(resType, upstream+"_") {
+		return ""
+	}
+	token, err := bridge.PulumiTypeToken(resType, &info.Provider{
+		Name:           pulumiProviderName,
+		ResourcePrefix: upstream,
+		// PulumiTypeToken consults Resources for token overrides; stub the entry so the default
+		// derivation path is taken.
+		Resources: map[string]*info.Resource{resType: {}},
+	})
+	if err != nil {
+		return ""
+	}
+	return string(token)
+}
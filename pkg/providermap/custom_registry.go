@@ -0,0 +1,81 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providermap
+
+import (
+	"slices"
+	"strings"
+)
+
+// requireExactRegistryHost is the process-wide setting controlled by the
+// --require-exact-registry-host flag.
+var requireExactRegistryHost bool
+
+// SetRequireExactRegistryHost disables registry host normalization, so provider source addresses
+// only match the provider map when the host is literally registry.terraform.io or
+// registry.opentofu.org. The default is to ignore the host for states pulled through private or
+// mirrored registries.
+func SetRequireExactRegistryHost(require bool) {
+	requireExactRegistryHost = require
+}
+
+// knownRegistryHosts are the hosts the provider map keys directly, tried in order during
+// normalization.
+var knownRegistryHosts = []string{"registry.terraform.io", "registry.opentofu.org"}
+
+// lookupProviderMapping resolves a provider source address against the provider map. Exact
+// matches win; otherwise the registry host is normalized away so addresses served through a
+// private or mirrored registry, such as "registry.example.com/acme/aws", still resolve when the
+// provider itself is known. Normalization first retries the same namespace and type under the
+// known registry hosts, then falls back to matching the provider type alone, since mirrors
+// commonly republish providers under their own namespace. SetRequireExactRegistryHost turns
+// normalization off.
+func lookupProviderMapping(addr TerraformProviderName) (providerMappingDetail, bool) {
+	if mapping, ok := providerMapping[addr]; ok {
+		return mapping, true
+	}
+	if requireExactRegistryHost {
+		return providerMappingDetail{}, false
+	}
+
+	parts := strings.Split(string(addr), "/")
+	if len(parts) != 3 {
+		return providerMappingDetail{}, false
+	}
+	namespace, providerType := parts[1], parts[2]
+
+	for _, host := range knownRegistryHosts {
+		candidate := TerraformProviderName(host + "/" + namespace + "/" + providerType)
+		if mapping, ok := providerMapping[candidate]; ok {
+			return mapping, true
+		}
+	}
+
+	// Match on the provider type alone, scanning keys in sorted order so the result is
+	// deterministic when several namespaces publish a provider of the same name.
+	keys := make([]string, 0, len(providerMapping))
+	for key := range providerMapping {
+		keys = append(keys, string(key))
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		keyParts := strings.Split(key, "/")
+		if len(keyParts) == 3 && keyParts[2] == providerType {
+			return providerMapping[TerraformProviderName(key)], true
+		}
+	}
+
+	return providerMappingDetail{}, false
+}
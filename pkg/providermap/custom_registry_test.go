@@ -0,0 +1,59 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providermap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Not parallel: mutates the package-level require-exact-registry-host setting.
+func Test_lookupProviderMapping(t *testing.T) {
+	t.Run("exact addresses resolve", func(t *testing.T) {
+		mapping, ok := lookupProviderMapping("registry.terraform.io/hashicorp/aws")
+		require.True(t, ok)
+		require.Equal(t, "aws", mapping.pulumiProviderName)
+	})
+
+	t.Run("a private registry host is normalized away", func(t *testing.T) {
+		mapping, ok := lookupProviderMapping("registry.example.com/hashicorp/aws")
+		require.True(t, ok)
+		require.Equal(t, "aws", mapping.pulumiProviderName)
+	})
+
+	t.Run("a mirrored namespace falls back to the provider type", func(t *testing.T) {
+		mapping, ok := lookupProviderMapping("registry.example.com/acme/aws")
+		require.True(t, ok)
+		require.Equal(t, "aws", mapping.pulumiProviderName)
+	})
+
+	t.Run("unknown provider types do not resolve", func(t *testing.T) {
+		_, ok := lookupProviderMapping("registry.example.com/acme/no-such-provider")
+		require.False(t, ok)
+	})
+
+	t.Run("require-exact-registry-host disables normalization", func(t *testing.T) {
+		SetRequireExactRegistryHost(true)
+		defer SetRequireExactRegistryHost(false)
+
+		_, ok := lookupProviderMapping("registry.example.com/hashicorp/aws")
+		require.False(t, ok)
+
+		mapping, ok := lookupProviderMapping("registry.opentofu.org/hashicorp/aws")
+		require.True(t, ok)
+		require.Equal(t, "aws", mapping.pulumiProviderName)
+	})
+}
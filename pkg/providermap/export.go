@@ -0,0 +1,54 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providermap
+
+import "sort"
+
+// ProviderMapEntry is one entry of the exported provider map, matching the array format of
+// ci-mgmt's provider-ci/providers.json so the two lists can be diffed programmatically.
+type ProviderMapEntry struct {
+	// Name of the Pulumi provider, such as "aws" or "azuread".
+	Name string `json:"name"`
+
+	// Name of the upstream Terraform provider, such as "aws" or "azurerm". Omitted when it
+	// matches the Pulumi provider name, mirroring the ci-mgmt convention.
+	Provider string `json:"provider,omitempty"`
+}
+
+// ExportProviderMap returns the tool's known statically bridged providers as a deduplicated list
+// sorted by Pulumi provider name. Registry-host variants of the same provider (terraform.io and
+// opentofu.org) collapse into a single entry.
+func ExportProviderMap() []ProviderMapEntry {
+	seen := map[string]ProviderMapEntry{}
+	for _, detail := range providerMapping {
+		entry := ProviderMapEntry{Name: detail.pulumiProviderName}
+		if detail.terraformProviderName != detail.pulumiProviderName {
+			entry.Provider = detail.terraformProviderName
+		}
+		// Keep a deterministic entry when several Terraform providers bridge to one Pulumi
+		// provider.
+		if existing, ok := seen[detail.pulumiProviderName]; ok && existing.Provider <= entry.Provider {
+			continue
+		}
+		seen[detail.pulumiProviderName] = entry
+	}
+
+	entries := make([]ProviderMapEntry, 0, len(seen))
+	for _, entry := range seen {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries
+}
@@ -0,0 +1,51 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package providermap
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportProviderMap(t *testing.T) {
+	t.Parallel()
+
+	entries := ExportProviderMap()
+	require.NotEmpty(t, entries)
+
+	assert.True(t, sort.SliceIsSorted(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	}), "entries should be sorted by Pulumi provider name")
+
+	byName := map[string]ProviderMapEntry{}
+	for _, entry := range entries {
+		_, duplicate := byName[entry.Name]
+		assert.False(t, duplicate, "duplicate entry for %s", entry.Name)
+		byName[entry.Name] = entry
+	}
+
+	// Providers whose Pulumi name matches upstream omit the provider field.
+	aws, ok := byName["aws"]
+	require.True(t, ok)
+	assert.Empty(t, aws.Provider)
+
+	// Providers renamed on the Pulumi side carry the upstream name.
+	gcp, ok := byName["gcp"]
+	require.True(t, ok)
+	assert.Equal(t, "google", gcp.Provider)
+}
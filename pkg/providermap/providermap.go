@@ -620,9 +620,20 @@ var providerMapping = map[TerraformProviderName]providerMappingDetail{
 	},
 }
 
+// UpstreamProviderName returns the name of the upstream Terraform provider for a Terraform
+// provider identifier, e.g. "azurerm" for "registry.terraform.io/hashicorp/azurerm". The upstream
+// name is the prefix of the provider's resource type names.
+func UpstreamProviderName(addr TerraformProviderName) (string, bool) {
+	mapping, ok := lookupProviderMapping(addr)
+	if !ok {
+		return "", false
+	}
+	return mapping.terraformProviderName, true
+}
+
 func RecommendPulumiProvider(tf TerraformProvider) RecommendedPulumiProvider {
 	// Check if there is a bridged provider for this Terraform provider
-	mapping, ok := providerMapping[tf.Identifier]
+	mapping, ok := lookupProviderMapping(tf.Identifier)
 	if !ok {
 		// Default to using terraform-provider package if no bridged provider exists
 		return RecommendedPulumiProvider{UseDynamicBridging: true}
@@ -738,7 +749,7 @@ func latestVersionMatchingMajor(versionPairs []VersionPair, v semver.Version) (R
 // and Pulumi provider version. If pulumiVersion is empty, returns the latest version.
 // This is useful for loading the TF provider binary for state upgrades.
 func GetUpstreamVersion(addr TerraformProviderName, pulumiVersion string) (string, bool) {
-	mapping, ok := providerMapping[addr]
+	mapping, ok := lookupProviderMapping(addr)
 	if !ok {
 		return "", false
 	}
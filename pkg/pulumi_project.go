@@ -0,0 +1,52 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ReadPulumiProjectName reads the project name from the Pulumi project file in dir. Both
+// Pulumi.yaml and Pulumi.yml are recognized; JSON-formatted project files parse as well since
+// JSON is a subset of YAML.
+func ReadPulumiProjectName(dir string) (string, error) {
+	for _, fileName := range []string{"Pulumi.yaml", "Pulumi.yml"} {
+		path := filepath.Join(dir, fileName)
+		data, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		var project struct {
+			Name string `yaml:"name"`
+		}
+		if err := yaml.Unmarshal(data, &project); err != nil {
+			return "", fmt.Errorf("%s is not a valid Pulumi project file: %w", path, err)
+		}
+		if project.Name == "" {
+			return "", fmt.Errorf("%s does not declare a project name", path)
+		}
+		return project.Name, nil
+	}
+	return "", fmt.Errorf("no Pulumi project found in %s: expected a Pulumi.yaml (or Pulumi.yml) file", dir)
+}
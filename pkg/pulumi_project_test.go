@@ -0,0 +1,87 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPulumiProjectName(t *testing.T) {
+	t.Parallel()
+
+	write := func(t *testing.T, fileName, content string) string {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fileName), []byte(content), 0o600))
+		return dir
+	}
+
+	t.Run("Pulumi.yaml", func(t *testing.T) {
+		t.Parallel()
+		dir := write(t, "Pulumi.yaml", "name: my-project\nruntime: nodejs\n")
+		name, err := ReadPulumiProjectName(dir)
+		require.NoError(t, err)
+		assert.Equal(t, "my-project", name)
+	})
+
+	t.Run("Pulumi.yml", func(t *testing.T) {
+		t.Parallel()
+		dir := write(t, "Pulumi.yml", "name: my-project\nruntime: nodejs\n")
+		name, err := ReadPulumiProjectName(dir)
+		require.NoError(t, err)
+		assert.Equal(t, "my-project", name)
+	})
+
+	t.Run("unusual field ordering", func(t *testing.T) {
+		t.Parallel()
+		dir := write(t, "Pulumi.yaml", "runtime: nodejs\ndescription: a project\nname: my-project\n")
+		name, err := ReadPulumiProjectName(dir)
+		require.NoError(t, err)
+		assert.Equal(t, "my-project", name)
+	})
+
+	t.Run("JSON-formatted project file", func(t *testing.T) {
+		t.Parallel()
+		dir := write(t, "Pulumi.yaml", `{"name": "my-project", "runtime": "nodejs"}`)
+		name, err := ReadPulumiProjectName(dir)
+		require.NoError(t, err)
+		assert.Equal(t, "my-project", name)
+	})
+
+	t.Run("missing project file", func(t *testing.T) {
+		t.Parallel()
+		_, err := ReadPulumiProjectName(t.TempDir())
+		require.ErrorContains(t, err, "no Pulumi project found")
+		require.ErrorContains(t, err, "Pulumi.yaml")
+	})
+
+	t.Run("project file without a name", func(t *testing.T) {
+		t.Parallel()
+		dir := write(t, "Pulumi.yaml", "runtime: nodejs\n")
+		_, err := ReadPulumiProjectName(dir)
+		require.ErrorContains(t, err, "does not declare a project name")
+	})
+
+	t.Run("malformed project file", func(t *testing.T) {
+		t.Parallel()
+		dir := write(t, "Pulumi.yaml", "name: [unclosed\n")
+		_, err := ReadPulumiProjectName(dir)
+		require.ErrorContains(t, err, "not a valid Pulumi project file")
+	})
+}
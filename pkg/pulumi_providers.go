@@ -57,6 +57,25 @@ type ProviderWithMetadata struct {
 	// This is set for all providers, but is primarily useful for dynamic providers
 	// to construct the proper package name.
 	TerraformAddress string
+	// PulumiName is the name the Pulumi package is published under when it differs from the
+	// Terraform provider name (e.g. "azure" for azurerm, "gcp" for google). Empty when the two
+	// coincide and for dynamically bridged providers.
+	PulumiName string
+	// LoadError records why the provider failed to install or report its schema when
+	// --continue-on-provider-error is in effect. Provider is nil in that case; resources served by
+	// the provider are skipped with this error.
+	LoadError error
+}
+
+// PulumiPackageName returns the name of the Pulumi package serving this provider: the plugin to
+// install and the <name> in its pulumi:providers:<name> token. The bridge metadata's Name field
+// carries the Terraform provider name, which for a few providers differs from the Pulumi package
+// name; prefer this method over Name whenever the Pulumi side is meant.
+func (p *ProviderWithMetadata) PulumiPackageName() string {
+	if p.PulumiName != "" {
+		return p.PulumiName
+	}
+	return p.Name
 }
 
 func PulumiProvidersForTerraformProviders(
@@ -79,14 +98,27 @@ func PulumiProvidersForTerraformProviders(
 
 		var providerInfo *info.Provider
 		var isDynamic bool
+		var pulumiName string
 		var err error
 
 		if pulumiProvider.StaticallyBridgedProvider != nil {
 			providerInfo, err = getMappingFromStaticallyBridgedProvider(pulumiProvider.StaticallyBridgedProvider, providerName)
 			if err != nil {
-				return nil, err
+				if !continueOnProviderError {
+					return nil, err
+				}
+				fmt.Fprintf(os.Stderr, "Warning: failed to load provider %s: %v\n", providerName, err)
+				fmt.Fprintf(os.Stderr, "Warning: resources using provider %s will be skipped\n", providerName)
+				pulumiProviders[providerName] = &ProviderWithMetadata{
+					TerraformAddress: string(providerName),
+					LoadError:        err,
+				}
+				continue
 			}
 			isDynamic = false
+			if pulumiProvider.StaticallyBridgedProvider.Identifier != providerInfo.Name {
+				pulumiName = pulumiProvider.StaticallyBridgedProvider.Identifier
+			}
 		} else {
 			providerInfo, err = bridgedproviders.GetMappingForTerraformProvider(
 				context.Background(),
@@ -96,6 +128,13 @@ func PulumiProvidersForTerraformProviders(
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Warning: failed to dynamically bridge provider %s: %v\n", providerName, err)
 				fmt.Fprintf(os.Stderr, "Warning: resources using provider %s will be skipped\n", providerName)
+				if continueOnProviderError {
+					pulumiProviders[providerName] = &ProviderWithMetadata{
+						TerraformAddress: string(providerName),
+						IsDynamic:        true,
+						LoadError:        err,
+					}
+				}
 				continue
 			}
 			isDynamic = true
@@ -105,6 +144,7 @@ func PulumiProvidersForTerraformProviders(
 			Provider:         providerInfo,
 			IsDynamic:        isDynamic,
 			TerraformAddress: string(providerName),
+			PulumiName:       pulumiName,
 		}
 	}
 	return pulumiProviders, nil
@@ -114,6 +154,13 @@ func getMappingFromStaticallyBridgedProvider(
 	staticProvider *providermap.BridgedPulumiProvider,
 	tfProviderName providermap.TerraformProviderName,
 ) (*info.Provider, error) {
+	// Pre-exported schemas take precedence so air-gapped runs never hit the network.
+	if offline, err := loadOfflineProviderMapping(staticProvider.Identifier); err != nil {
+		return nil, err
+	} else if offline != nil {
+		return offline, nil
+	}
+
 	result, err := bridgedproviders.EnsureProviderInstalled(context.Background(), bridgedproviders.InstallProviderOptions{
 		Name:    staticProvider.Identifier,
 		Version: staticProvider.Version,
@@ -147,7 +194,18 @@ func GetPulumiProvidersForTerraformState(tfState *tfjson.State, providerVersions
 	return PulumiProvidersForTerraformProviders(terraformProviders, providerVersions)
 }
 
-func GetProviderInputs(providerName string) (resource.PropertyMap, error) {
+// GetProviderInputs synthesizes the inputs for an imported Pulumi provider resource. When the
+// Terraform project configures the provider explicitly (region, endpoints, assume_role, ...), that
+// configuration is carried over so the imported provider matches the Terraform provider's settings.
+// Under --esc-provider-config, credential attributes are omitted so the provider resolves them
+// from a Pulumi ESC environment or environment variables.
+func GetProviderInputs(providerName string, config resource.PropertyMap) (resource.PropertyMap, error) {
 	// TODO[pulumi/pulumi-service#35411]: produce correct provider inputs or fail gracefully with instructions
-	return resource.PropertyMap{}, nil
+	if config == nil {
+		return resource.PropertyMap{}, nil
+	}
+	if deferProviderCredentials {
+		config = filterProviderCredentials(providerName, config)
+	}
+	return config, nil
 }
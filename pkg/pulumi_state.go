@@ -58,6 +58,15 @@ type PulumiResource struct {
 type PulumiState struct {
 	Providers []PulumiResource
 	Resources []PulumiResource
+
+	// StackReferences are pulumi:pulumi:StackReference resources synthesized from
+	// terraform_remote_state data sources. Unlike Resources they carry no provider link: stack
+	// references are served by the builtin provider, which Pulumi links implicitly.
+	StackReferences []PulumiResource
+
+	// Stack-level outputs translated from the Terraform root module outputs. Sensitive outputs
+	// are represented as secrets.
+	Outputs resource.PropertyMap
 }
 
 func (st PulumiState) FindProvider(identity PulumiResourceID) (PulumiResource, error) {
@@ -105,17 +114,22 @@ type DeploymentResult struct {
 }
 
 func GetDeployment(outputFolder string) (*DeploymentResult, error) {
-	ctx := context.Background()
-	workspace, err := auto.NewLocalWorkspace(ctx, auto.WorkDir(outputFolder))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create workspace: %w", err)
-	}
-
 	// TODO[pulumi/pulumi#21266]: Use automation API to get the selected stack name once the issue is fixed.
 	stackName, err := getStackName(outputFolder)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get stack name: %w", err)
 	}
+	return GetDeploymentForStack(outputFolder, stackName)
+}
+
+// GetDeploymentForStack exports a named stack of the Pulumi project in outputFolder, rather than
+// the currently selected one.
+func GetDeploymentForStack(outputFolder, stackName string) (*DeploymentResult, error) {
+	ctx := context.Background()
+	workspace, err := auto.NewLocalWorkspace(ctx, auto.WorkDir(outputFolder))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workspace: %w", err)
+	}
 
 	untypedDeployment, err := workspace.ExportStack(ctx, stackName)
 	if err != nil {
@@ -145,22 +159,13 @@ func GetDeployment(outputFolder string) (*DeploymentResult, error) {
 }
 
 func InsertResourcesIntoDeployment(state *PulumiState, stackName, projectName string, deployment apitype.DeploymentV3) (apitype.DeploymentV3, error) {
-	nres := len(deployment.Resources)
-
-	if nres == 0 {
+	if len(deployment.Resources) == 0 {
 		return apitype.DeploymentV3{}, fmt.Errorf(
 			"No Stack resource found in the Pulumi state for stack '%q'. "+
 				"Please run `pulumi up` to populate the initial Pulumi state and configure secrets providers, then try again.",
 			stackName)
 	}
 
-	if nres > 1 {
-		return apitype.DeploymentV3{}, fmt.Errorf(
-			"Found %d resources in stack %q, expected 1 (Stack resource). "+
-				"Migrating resources into stacks with pre-existing resources is not yet supported",
-			nres, stackName)
-	}
-
 	now := time.Now()
 
 	stackResource, err := findStackResource(deployment)
@@ -168,9 +173,48 @@ func InsertResourcesIntoDeployment(state *PulumiState, stackName, projectName st
 		return apitype.DeploymentV3{}, err
 	}
 
+	// The deployment may already contain Pulumi-managed resources; translated resources are
+	// inserted alongside them. Track the existing URNs so a collision fails up front instead of
+	// producing a corrupt checkpoint.
+	existingURNs := map[resource.URN]bool{}
+	for _, r := range deployment.Resources {
+		existingURNs[r.URN] = true
+	}
+	claimURN := func(urn resource.URN) error {
+		if existingURNs[urn] {
+			return fmt.Errorf(
+				"cannot insert resource %q: stack %q already has a resource with this URN",
+				urn, stackName)
+		}
+		existingURNs[urn] = true
+		return nil
+	}
+
+	// Attach translated Terraform root-module outputs to the Stack resource so they surface as
+	// Pulumi stack outputs.
+	if len(state.Outputs) > 0 {
+		for i := range deployment.Resources {
+			if string(deployment.Resources[i].URN.QualifiedType()) != "pulumi:pulumi:Stack" {
+				continue
+			}
+			outputs := deployment.Resources[i].Outputs
+			if outputs == nil {
+				outputs = map[string]interface{}{}
+			}
+			for key, value := range state.Outputs.Mappable() {
+				outputs[key] = value
+			}
+			deployment.Resources[i].Outputs = outputs
+		}
+	}
+
 	for _, providerState := range state.Providers {
+		urn := makeUrn(stackName, projectName, providerState.Type, providerState.Name)
+		if err := claimURN(urn); err != nil {
+			return apitype.DeploymentV3{}, err
+		}
 		provider := apitype.ResourceV3{
-			URN:      makeUrn(stackName, projectName, providerState.Type, providerState.Name),
+			URN:      urn,
 			Custom:   true,
 			ID:       resource.ID(providerState.ID),
 			Type:     tokens.Type(providerState.Type),
@@ -182,6 +226,24 @@ func InsertResourcesIntoDeployment(state *PulumiState, stackName, projectName st
 		deployment.Resources = append(deployment.Resources, provider)
 	}
 
+	for _, ref := range state.StackReferences {
+		urn := makeUrn(stackName, projectName, ref.Type, ref.Name)
+		if err := claimURN(urn); err != nil {
+			return apitype.DeploymentV3{}, err
+		}
+		deployment.Resources = append(deployment.Resources, apitype.ResourceV3{
+			URN:      urn,
+			Custom:   true,
+			ID:       resource.ID(ref.ID),
+			Type:     tokens.Type(ref.Type),
+			Inputs:   ref.Inputs.Mappable(),
+			Outputs:  ref.Outputs.Mappable(),
+			Parent:   resource.URN(stackResource.URN),
+			Created:  &now,
+			Modified: &now,
+		})
+	}
+
 	for _, res := range state.Resources {
 		contract.Assertf(res.Provider != nil, "Expected a provider association for a custom resource")
 
@@ -193,8 +255,13 @@ func InsertResourcesIntoDeployment(state *PulumiState, stackName, projectName st
 		providerURN := makeUrn(stackName, projectName, providerRecord.Type, providerRecord.Name)
 		providerLink := fmt.Sprintf("%s::%s", providerURN, providerRecord.ID)
 
+		urn := makeUrn(stackName, projectName, res.Type, res.Name)
+		if err := claimURN(urn); err != nil {
+			return apitype.DeploymentV3{}, err
+		}
+
 		deployment.Resources = append(deployment.Resources, apitype.ResourceV3{
-			URN:      makeUrn(stackName, projectName, res.Type, res.Name),
+			URN:      urn,
 			Custom:   true,
 			ID:       resource.ID(res.ID),
 			Type:     tokens.Type(res.Type),
@@ -225,6 +225,35 @@ func TestInsertResourcesIntoDeployment_multi_provider(t *testing.T) {
 		"tls_private_key should be linked to tls provider")
 }
 
+func TestInsertResourcesIntoDeployment_StackOutputs(t *testing.T) {
+	t.Parallel()
+
+	data, err := InsertResourcesIntoDeployment(&PulumiState{
+		Outputs: resource.PropertyMap{
+			"endpoint":    resource.NewProperty("https://example.com"),
+			"db_password": resource.MakeSecret(resource.NewProperty("hunter2")),
+		},
+	}, "dev", "example", apitype.DeploymentV3{
+		Resources: []apitype.ResourceV3{
+			{
+				URN:  "urn:pulumi:dev::example::pulumi:pulumi:Stack::example-dev",
+				Type: "pulumi:pulumi:Stack",
+				ID:   "stack-id",
+			},
+		},
+	})
+	require.NoError(t, err, "failed to make deployment")
+
+	require.Equal(t, 1, len(data.Resources))
+	stackResource := data.Resources[0]
+	require.Equal(t, "https://example.com", stackResource.Outputs["endpoint"])
+
+	// Sensitive outputs round-trip as secrets.
+	secret, ok := stackResource.Outputs["db_password"].(*resource.Secret)
+	require.True(t, ok, "db_password should be a secret")
+	require.Equal(t, "hunter2", secret.Element.StringValue())
+}
+
 func runCommand(t *testing.T, dir string, command string, args ...string) string {
 	t.Helper()
 	cmd := exec.Command(command, args...)
@@ -259,19 +288,75 @@ func TestInsertResourcesIntoDeployment_ZeroResources(t *testing.T) {
 	require.Contains(t, err.Error(), "No Stack resource found")
 }
 
-func TestInsertResourcesIntoDeployment_MultipleResources(t *testing.T) {
+func TestInsertResourcesIntoDeployment_PreExistingResources(t *testing.T) {
 	t.Parallel()
+
+	awsProviderID := PulumiResourceID{
+		ID:   "a339fe8e-e15d-4203-8719-c0ca5d3f414e",
+		Type: "pulumi:providers:aws",
+		Name: "default_7.12.0",
+	}
+
+	existing := []apitype.ResourceV3{
+		{
+			URN:  "urn:pulumi:dev::example::pulumi:pulumi:Stack::example-dev",
+			Type: "pulumi:pulumi:Stack",
+			ID:   "a339fe8e-e15d-4203-8719-c0ca5d3f414e",
+		},
+		{
+			URN:  "urn:pulumi:dev::example::aws:s3/bucket:Bucket::my-bucket",
+			Type: "aws:s3/bucket:Bucket",
+			ID:   "b339fe8e-e15d-4203-8719-c0ca5d3f414f",
+		},
+	}
+
+	data, err := InsertResourcesIntoDeployment(&PulumiState{
+		Providers: []PulumiResource{
+			{PulumiResourceID: awsProviderID},
+		},
+		Resources: []PulumiResource{
+			{
+				PulumiResourceID: PulumiResourceID{
+					ID:   "c339fe8e-e15d-4203-8719-c0ca5d3f4150",
+					Type: "aws_s3_bucket",
+					Name: "migrated",
+				},
+				Provider: &awsProviderID,
+			},
+		},
+	}, "dev", "example", apitype.DeploymentV3{Resources: existing})
+	require.NoError(t, err, "merging into a stack with pre-existing resources should succeed")
+
+	require.Equal(t, 4, len(data.Resources), "expected 4 resources (stack, pre-existing bucket, provider, migrated bucket)")
+
+	// Pre-existing resources are preserved untouched, in their original order.
+	require.Equal(t, existing[0].URN, data.Resources[0].URN)
+	require.Equal(t, existing[1].URN, data.Resources[1].URN)
+}
+
+func TestInsertResourcesIntoDeployment_URNCollision(t *testing.T) {
+	t.Parallel()
+
+	awsProviderID := PulumiResourceID{
+		ID:   "a339fe8e-e15d-4203-8719-c0ca5d3f414e",
+		Type: "pulumi:providers:aws",
+		Name: "default_7.12.0",
+	}
+
 	_, err := InsertResourcesIntoDeployment(&PulumiState{
 		Providers: []PulumiResource{
+			{PulumiResourceID: awsProviderID},
+		},
+		Resources: []PulumiResource{
 			{
 				PulumiResourceID: PulumiResourceID{
-					ID:   "a339fe8e-e15d-4203-8719-c0ca5d3f414e",
-					Type: "pulumi:providers:aws",
-					Name: "default_7.12.0",
+					ID:   "c339fe8e-e15d-4203-8719-c0ca5d3f4150",
+					Type: "aws:s3/bucket:Bucket",
+					Name: "my-bucket",
 				},
+				Provider: &awsProviderID,
 			},
 		},
-		Resources: []PulumiResource{},
 	}, "dev", "example", apitype.DeploymentV3{
 		Resources: []apitype.ResourceV3{
 			{
@@ -287,8 +372,7 @@ func TestInsertResourcesIntoDeployment_MultipleResources(t *testing.T) {
 		},
 	})
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "Found 2 resources")
-	require.Contains(t, err.Error(), "expected 1")
+	require.Contains(t, err.Error(), "already has a resource with this URN")
 }
 
 func TestGetDeployment(t *testing.T) {
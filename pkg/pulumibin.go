@@ -0,0 +1,62 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/blang/semver"
+)
+
+// minPulumiVersion is the oldest Pulumi CLI this tool works with; older CLIs predate the stack
+// import features the translation relies on.
+var minPulumiVersion = semver.MustParse("3.0.0")
+
+// SetPulumiBinary pins the Pulumi CLI used for the rest of the process. Both the automation API
+// and the shelled-out pulumi commands resolve the CLI through PATH, so the binary's directory is
+// placed at the front of PATH after validating that it exists, runs, and is recent enough. This
+// lets users with multiple Pulumi versions pick the one used for preview and import.
+func SetPulumiBinary(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("pulumi binary %s not found: %w", path, err)
+	}
+	if filepath.Base(path) != "pulumi" {
+		return fmt.Errorf("pulumi binary must be named \"pulumi\", got %s", filepath.Base(path))
+	}
+
+	out, err := exec.Command(path, "version").Output()
+	if err != nil {
+		return fmt.Errorf("%s is not a working Pulumi CLI: failed to run `pulumi version`: %w", path, err)
+	}
+	version, err := semver.ParseTolerant(strings.TrimSpace(string(out)))
+	if err != nil {
+		return fmt.Errorf("%s is not a working Pulumi CLI: cannot parse version %q: %w",
+			path, strings.TrimSpace(string(out)), err)
+	}
+	if version.LT(minPulumiVersion) {
+		return fmt.Errorf("pulumi binary %s is too old: version %s, need at least %s",
+			path, version, minPulumiVersion)
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	return os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
@@ -0,0 +1,104 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// emitStackReferences controls whether terraform_remote_state data sources are translated into
+// Pulumi StackReference resources instead of only being reported.
+var emitStackReferences bool
+
+// SetEmitStackReferences makes the translation synthesize a pulumi:pulumi:StackReference resource
+// for every terraform_remote_state data source, as requested by the --emit-stack-references flag.
+// Off by default: the synthesized stack name is a best-effort guess from the backend config and
+// usually needs review before the state is imported.
+func SetEmitStackReferences(v bool) {
+	emitStackReferences = v
+}
+
+// remoteStateDataSources collects the terraform_remote_state data sources in the state. They are
+// the one kind of data source with a direct Pulumi equivalent (a StackReference), so they are
+// reported distinctly rather than skipped silently with the rest.
+func remoteStateDataSources(tfState *tfjson.State) []*tfjson.StateResource {
+	var found []*tfjson.StateResource
+	_ = tofu.VisitResources(tfState, func(res *tfjson.StateResource) error {
+		if res.Mode == tfjson.DataResourceMode && res.Type == "terraform_remote_state" {
+			found = append(found, res)
+		}
+		return nil
+	}, &tofu.VisitOptions{IncludeDataSources: true})
+	return found
+}
+
+// synthesizeStackReference maps a terraform_remote_state data source onto a
+// pulumi:pulumi:StackReference resource carrying the same outputs. The referenced stack name is
+// derived from the backend config and is only a placeholder until the consumed stack is itself
+// migrated; the caller warns the user to review it.
+func synthesizeStackReference(res *tfjson.StateResource) PulumiResource {
+	stackName := remoteStateStackName(res)
+	inputs := resource.PropertyMap{
+		"name": resource.NewStringProperty(stackName),
+	}
+	outputs := resource.PropertyMap{
+		"name": resource.NewStringProperty(stackName),
+	}
+	if remoteOutputs, ok := res.AttributeValues["outputs"].(map[string]interface{}); ok {
+		outputs["outputs"] = resource.NewObjectProperty(resource.NewPropertyMapFromMap(remoteOutputs))
+	}
+	return PulumiResource{
+		PulumiResourceID: PulumiResourceID{
+			ID:   stackName,
+			Name: PulumiNameFromTerraformAddress(strings.TrimPrefix(res.Address, "data."), res.Type),
+			Type: "pulumi:pulumi:StackReference",
+		},
+		Inputs:  inputs,
+		Outputs: outputs,
+	}
+}
+
+// remoteStateStackName guesses the name of the Pulumi stack a terraform_remote_state data source
+// will correspond to once the producing configuration is migrated. The remote backend carries an
+// organization and workspace name; other backends fall back to whatever locates the state (the
+// local path, the s3 key) or, failing that, the data source's own name.
+func remoteStateStackName(res *tfjson.StateResource) string {
+	config, _ := res.AttributeValues["config"].(map[string]interface{})
+	if org, ok := config["organization"].(string); ok && org != "" {
+		if workspaces, ok := config["workspaces"].(map[string]interface{}); ok {
+			if name, ok := workspaces["name"].(string); ok && name != "" {
+				return fmt.Sprintf("%s/%s", org, name)
+			}
+		}
+	}
+	for _, key := range []string{"path", "key"} {
+		if value, ok := config[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	return res.Name
+}
+
+// remoteStateSkipMessage is the distinct skip reason reported for terraform_remote_state data
+// sources when --emit-stack-references is off.
+func remoteStateSkipMessage() string {
+	return "reads another stack's outputs via terraform_remote_state; " +
+		"re-run with --emit-stack-references to translate it into a Pulumi StackReference"
+}
@@ -0,0 +1,108 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_remoteStateDataSources(t *testing.T) {
+	t.Parallel()
+	state := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{
+					{
+						Address: "data.terraform_remote_state.network",
+						Mode:    tfjson.DataResourceMode,
+						Type:    "terraform_remote_state",
+						Name:    "network",
+					},
+					{
+						Address: "data.aws_ami.ubuntu",
+						Mode:    tfjson.DataResourceMode,
+						Type:    "aws_ami",
+						Name:    "ubuntu",
+					},
+					{
+						Address: "aws_instance.web",
+						Mode:    tfjson.ManagedResourceMode,
+						Type:    "aws_instance",
+						Name:    "web",
+					},
+				},
+			},
+		},
+	}
+
+	found := remoteStateDataSources(state)
+	require.Len(t, found, 1)
+	assert.Equal(t, "data.terraform_remote_state.network", found[0].Address)
+}
+
+func Test_synthesizeStackReference(t *testing.T) {
+	t.Parallel()
+
+	t.Run("remote backend derives org/workspace stack name", func(t *testing.T) {
+		t.Parallel()
+		ref := synthesizeStackReference(&tfjson.StateResource{
+			Address: "data.terraform_remote_state.network",
+			Type:    "terraform_remote_state",
+			Name:    "network",
+			AttributeValues: map[string]interface{}{
+				"backend": "remote",
+				"config": map[string]interface{}{
+					"organization": "acme",
+					"workspaces":   map[string]interface{}{"name": "network-prod"},
+				},
+				"outputs": map[string]interface{}{"vpc_id": "vpc-123"},
+			},
+		})
+		assert.Equal(t, "pulumi:pulumi:StackReference", ref.Type)
+		assert.Equal(t, "acme/network-prod", ref.ID)
+		assert.Equal(t, "network", ref.Name)
+		assert.Equal(t, "acme/network-prod", ref.Inputs["name"].StringValue())
+		assert.Equal(t, "vpc-123",
+			ref.Outputs["outputs"].ObjectValue()["vpc_id"].StringValue())
+	})
+
+	t.Run("local backend falls back to the state path", func(t *testing.T) {
+		t.Parallel()
+		ref := synthesizeStackReference(&tfjson.StateResource{
+			Address: "data.terraform_remote_state.network",
+			Type:    "terraform_remote_state",
+			Name:    "network",
+			AttributeValues: map[string]interface{}{
+				"backend": "local",
+				"config":  map[string]interface{}{"path": "../network/terraform.tfstate"},
+			},
+		})
+		assert.Equal(t, "../network/terraform.tfstate", ref.ID)
+	})
+
+	t.Run("no usable config falls back to the data source name", func(t *testing.T) {
+		t.Parallel()
+		ref := synthesizeStackReference(&tfjson.StateResource{
+			Address: "data.terraform_remote_state.network",
+			Type:    "terraform_remote_state",
+			Name:    "network",
+		})
+		assert.Equal(t, "network", ref.ID)
+	})
+}
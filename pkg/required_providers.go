@@ -0,0 +1,98 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/google/uuid"
+)
+
+// providerResourceIDNamespace is the fixed UUIDv5 namespace for provider resource IDs.
+var providerResourceIDNamespace = uuid.MustParse("8f0f24f0-6d4f-4c39-9b8e-2f35a2d9a9c1")
+
+// providerResourceID derives the ID of a pulumi:providers:* resource deterministically from the
+// provider name and version, so repeated translations of the same state produce byte-identical
+// state.json files that can be diffed or used as golden test fixtures.
+func providerResourceID(provider *ProviderWithMetadata) string {
+	return uuid.NewSHA1(providerResourceIDNamespace,
+		[]byte(requiredProviderName(provider)+"@"+provider.Version)).String()
+}
+
+// requiredProviderName returns the name a provider is exported under in required-providers.json.
+// This is the Pulumi package name, since required-providers.json drives plugin installation.
+func requiredProviderName(provider *ProviderWithMetadata) string {
+	if provider.IsDynamic {
+		return formatDynamicProviderName(provider.TerraformAddress)
+	}
+	return provider.PulumiPackageName()
+}
+
+// dedupeRequiredProviders collapses providers that export under the same name, which happens when
+// merged states or aliased configs pull in the same provider at different versions. The highest
+// version wins; conflicting versions are reported with the Terraform provider addresses that
+// requested each so the duplicate list never reaches required-providers.json. The result is
+// sorted by name.
+func dedupeRequiredProviders(providers []*ProviderWithMetadata) []*ProviderWithMetadata {
+	byName := map[string][]*ProviderWithMetadata{}
+	for _, provider := range providers {
+		name := requiredProviderName(provider)
+		byName[name] = append(byName[name], provider)
+	}
+
+	deduped := make([]*ProviderWithMetadata, 0, len(byName))
+	for name, group := range byName {
+		best := group[0]
+		conflicting := false
+		for _, provider := range group[1:] {
+			if provider.Version != best.Version {
+				conflicting = true
+			}
+			if requiredProviderVersionLess(best.Version, provider.Version) {
+				best = provider
+			}
+		}
+		if conflicting {
+			var requests []string
+			for _, provider := range group {
+				requests = append(requests, fmt.Sprintf("%s (from %s)", provider.Version, provider.TerraformAddress))
+			}
+			sort.Strings(requests)
+			fmt.Fprintf(os.Stderr, "Warning: provider %s is requested at conflicting versions: %s; using %s\n",
+				name, strings.Join(requests, ", "), best.Version)
+		}
+		deduped = append(deduped, best)
+	}
+
+	sort.Slice(deduped, func(i, j int) bool {
+		return requiredProviderName(deduped[i]) < requiredProviderName(deduped[j])
+	})
+	return deduped
+}
+
+// requiredProviderVersionLess compares provider versions as semver, falling back to string
+// comparison for versions that don't parse.
+func requiredProviderVersionLess(a, b string) bool {
+	va, errA := semver.ParseTolerant(a)
+	vb, errB := semver.ParseTolerant(b)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return va.LT(vb)
+}
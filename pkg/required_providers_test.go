@@ -0,0 +1,91 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge/info"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_providerResourceID_deterministic(t *testing.T) {
+	t.Parallel()
+
+	aws := &ProviderWithMetadata{Provider: &info.Provider{Name: "aws", Version: "7.12.0"}}
+	require.Equal(t, providerResourceID(aws), providerResourceID(aws))
+
+	other := &ProviderWithMetadata{Provider: &info.Provider{Name: "aws", Version: "6.30.0"}}
+	require.NotEqual(t, providerResourceID(aws), providerResourceID(other))
+}
+
+func Test_dedupeRequiredProviders(t *testing.T) {
+	t.Parallel()
+
+	provider := func(name, version, tfAddr string) *ProviderWithMetadata {
+		return &ProviderWithMetadata{
+			Provider:         &info.Provider{Name: name, Version: version},
+			TerraformAddress: tfAddr,
+		}
+	}
+
+	t.Run("conflicting versions keep the highest", func(t *testing.T) {
+		t.Parallel()
+		deduped := dedupeRequiredProviders([]*ProviderWithMetadata{
+			provider("aws", "6.30.0", "registry.terraform.io/hashicorp/aws"),
+			provider("aws", "7.12.0", "registry.opentofu.org/hashicorp/aws"),
+			provider("random", "4.16.0", "registry.opentofu.org/hashicorp/random"),
+		})
+		require.Len(t, deduped, 2)
+		assert.Equal(t, "aws", deduped[0].Name)
+		assert.Equal(t, "7.12.0", deduped[0].Version)
+		assert.Equal(t, "random", deduped[1].Name)
+	})
+
+	t.Run("identical duplicates collapse without conflict", func(t *testing.T) {
+		t.Parallel()
+		deduped := dedupeRequiredProviders([]*ProviderWithMetadata{
+			provider("aws", "7.12.0", "registry.terraform.io/hashicorp/aws"),
+			provider("aws", "7.12.0", "registry.opentofu.org/hashicorp/aws"),
+		})
+		require.Len(t, deduped, 1)
+		assert.Equal(t, "7.12.0", deduped[0].Version)
+	})
+}
+
+func Test_requiredProviderName_pulumiPackageName(t *testing.T) {
+	t.Parallel()
+
+	// The bridge metadata names the azure provider after its Terraform counterpart; the exported
+	// name must be the Pulumi package so plugin install resolves pulumi-azure, not pulumi-azurerm.
+	azure := &ProviderWithMetadata{
+		Provider:   &info.Provider{Name: "azurerm", Version: "6.25.0"},
+		PulumiName: "azure",
+	}
+	assert.Equal(t, "azure", requiredProviderName(azure))
+
+	aws := &ProviderWithMetadata{Provider: &info.Provider{Name: "aws", Version: "7.12.0"}}
+	assert.Equal(t, "aws", requiredProviderName(aws))
+}
+
+func Test_requiredProviderVersionLess(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, requiredProviderVersionLess("6.30.0", "7.12.0"))
+	assert.False(t, requiredProviderVersionLess("10.0.0", "9.0.0"))
+	// Non-semver versions fall back to string comparison.
+	assert.True(t, requiredProviderVersionLess("abc", "abd"))
+}
@@ -0,0 +1,58 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge/info"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// ResourceImportID determines the Pulumi resource ID for a translated resource. Most resources
+// carry it in the "id" output, but some resource types derive their Pulumi ID differently and
+// declare a ComputeID in provider metadata, which is honored first. Numeric IDs are formatted as
+// strings; a missing, null or otherwise unusable id yields a descriptive error rather than a
+// panic so the resource can be skipped with a precise reason.
+func ResourceImportID(resourceInfo *info.Resource, props resource.PropertyMap, address string) (string, error) {
+	if resourceInfo != nil && resourceInfo.ComputeID != nil {
+		id, err := resourceInfo.ComputeID(context.Background(), props)
+		if err != nil {
+			return "", fmt.Errorf("failed to compute the Pulumi ID for %s: %w", address, err)
+		}
+		return string(id), nil
+	}
+
+	idProp, ok := props["id"]
+	if !ok || idProp.IsNull() {
+		return "", fmt.Errorf("resource %s has no id attribute; cannot determine an import ID", address)
+	}
+	if idProp.IsSecret() {
+		idProp = idProp.SecretValue().Element
+	}
+	switch {
+	case idProp.IsString():
+		if idProp.StringValue() == "" {
+			return "", fmt.Errorf("resource %s has an empty id attribute; cannot determine an import ID", address)
+		}
+		return idProp.StringValue(), nil
+	case idProp.IsNumber():
+		return strconv.FormatFloat(idProp.NumberValue(), 'f', -1, 64), nil
+	}
+	return "", fmt.Errorf("resource %s has an id attribute of unsupported type %v; cannot determine an import ID",
+		address, idProp.TypeString())
+}
@@ -0,0 +1,84 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge/info"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceImportID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("string id", func(t *testing.T) {
+		t.Parallel()
+		id, err := ResourceImportID(nil, resource.PropertyMap{
+			"id": resource.NewStringProperty("i-123"),
+		}, "aws_instance.web")
+		require.NoError(t, err)
+		assert.Equal(t, "i-123", id)
+	})
+
+	t.Run("numeric id formats as a string", func(t *testing.T) {
+		t.Parallel()
+		id, err := ResourceImportID(nil, resource.PropertyMap{
+			"id": resource.NewNumberProperty(12345),
+		}, "gitlab_project.main")
+		require.NoError(t, err)
+		assert.Equal(t, "12345", id)
+	})
+
+	t.Run("ComputeID from provider metadata wins", func(t *testing.T) {
+		t.Parallel()
+		resourceInfo := &info.Resource{
+			ComputeID: func(ctx context.Context, state resource.PropertyMap) (resource.ID, error) {
+				return resource.ID("custom-" + state["name"].StringValue()), nil
+			},
+		}
+		id, err := ResourceImportID(resourceInfo, resource.PropertyMap{
+			"id":   resource.NewStringProperty("ignored"),
+			"name": resource.NewStringProperty("web"),
+		}, "random_bytes.token")
+		require.NoError(t, err)
+		assert.Equal(t, "custom-web", id)
+	})
+
+	t.Run("missing id errors descriptively", func(t *testing.T) {
+		t.Parallel()
+		_, err := ResourceImportID(nil, resource.PropertyMap{}, "aws_instance.web")
+		require.ErrorContains(t, err, "aws_instance.web has no id attribute")
+	})
+
+	t.Run("null id errors descriptively", func(t *testing.T) {
+		t.Parallel()
+		_, err := ResourceImportID(nil, resource.PropertyMap{
+			"id": resource.NewNullProperty(),
+		}, "aws_instance.web")
+		require.ErrorContains(t, err, "has no id attribute")
+	})
+
+	t.Run("unsupported id type errors descriptively", func(t *testing.T) {
+		t.Parallel()
+		_, err := ResourceImportID(nil, resource.PropertyMap{
+			"id": resource.NewArrayProperty(nil),
+		}, "aws_instance.web")
+		require.ErrorContains(t, err, "unsupported type")
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+
+	shim "github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfshim"
+)
+
+// resourceTypeRenames maps Terraform resource types that newer provider major versions removed to
+// the type that replaced them. States written by an old provider can still reference the removed
+// type; retrying with the renamed type lets those resources translate instead of failing.
+var resourceTypeRenames = map[string]string{
+	"aws_s3_bucket_object": "aws_s3_object",
+}
+
+// resolveShimResource looks up the shim resource for a Terraform type in a bridged provider,
+// following known renames when the provider no longer carries the original type. Returns the shim
+// resource and the effective type to use for the rest of the translation. When neither the type
+// nor a rename resolves, the error states that the type was removed in the bridged provider
+// version rather than a generic lookup failure.
+func resolveShimResource(prov *ProviderWithMetadata, tfType string) (shim.Resource, string, error) {
+	if shimResource := prov.P.ResourcesMap().Get(tfType); shimResource != nil {
+		return shimResource, tfType, nil
+	}
+	if renamed, ok := resourceTypeRenames[tfType]; ok {
+		if shimResource := prov.P.ResourcesMap().Get(renamed); shimResource != nil {
+			fmt.Fprintf(os.Stderr, "Warning: resource type %s was renamed to %s in provider %s %s; translating as %s\n",
+				tfType, renamed, prov.Name, prov.Version, renamed)
+			return shimResource, renamed, nil
+		}
+	}
+	return nil, "", fmt.Errorf("resource type %s removed in provider %s version %s",
+		tfType, prov.Name, prov.Version)
+}
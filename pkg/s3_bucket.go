@@ -0,0 +1,188 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/providermap"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// awsSplitsS3SubResources reports whether the resolved AWS provider manages S3 bucket
+// configuration (versioning, lifecycle, acl) as separate resources. The upstream AWS provider
+// split these out of aws_s3_bucket in its 4.0 release; states written by older providers carry
+// the configuration inline, and translating it onto an aws:s3/bucketV2:BucketV2 would leave it
+// unmanaged. When the upstream version cannot be determined the provider is assumed current,
+// since every bridged AWS release the tool recommends today is based on upstream 4.x or newer.
+func awsSplitsS3SubResources(provider *ProviderWithMetadata) bool {
+	upstream, ok := providermap.GetUpstreamVersion(
+		providermap.TerraformProviderName(provider.TerraformAddress), provider.Version)
+	if !ok {
+		return true
+	}
+	v, err := semver.ParseTolerant(upstream)
+	if err != nil {
+		return true
+	}
+	return v.Major >= 4
+}
+
+// synthesizeS3SubResources maps the inline S3 bucket configuration of an older-provider state
+// onto the separate Pulumi resources newer AWS providers use, with names derived from the bucket's
+// name. Only configuration that deviates from the bucket defaults produces a resource, so buckets
+// without versioning, lifecycle rules or a non-private ACL synthesize nothing. The returned
+// resources still need a unique name and a provider link, like any converted resource.
+func synthesizeS3SubResources(res *tfjson.StateResource) []PulumiResource {
+	if res.Type != "aws_s3_bucket" {
+		return nil
+	}
+	bucketID, _ := res.AttributeValues["id"].(string)
+	if bucketID == "" {
+		return nil
+	}
+	baseName := PulumiNameFromTerraformAddress(res.Address, res.Type)
+	bucket := resource.NewStringProperty(bucketID)
+
+	var synthesized []PulumiResource
+
+	if status, ok := inlineVersioningStatus(res.AttributeValues["versioning"]); ok {
+		props := resource.PropertyMap{
+			"bucket": bucket,
+			"versioningConfiguration": resource.NewObjectProperty(resource.PropertyMap{
+				"status": resource.NewStringProperty(status),
+			}),
+		}
+		synthesized = append(synthesized, PulumiResource{
+			PulumiResourceID: PulumiResourceID{
+				ID:   bucketID,
+				Name: baseName + "-versioning",
+				Type: "aws:s3/bucketVersioningV2:BucketVersioningV2",
+			},
+			Inputs:  props,
+			Outputs: props,
+		})
+	}
+
+	if rules := inlineLifecycleRules(res.AttributeValues["lifecycle_rule"]); len(rules) > 0 {
+		props := resource.PropertyMap{
+			"bucket": bucket,
+			"rules":  resource.NewArrayProperty(rules),
+		}
+		synthesized = append(synthesized, PulumiResource{
+			PulumiResourceID: PulumiResourceID{
+				ID:   bucketID,
+				Name: baseName + "-lifecycle",
+				Type: "aws:s3/bucketLifecycleConfigurationV2:BucketLifecycleConfigurationV2",
+			},
+			Inputs:  props,
+			Outputs: props,
+		})
+	}
+
+	if acl, ok := res.AttributeValues["acl"].(string); ok && acl != "" && acl != "private" {
+		props := resource.PropertyMap{
+			"bucket": bucket,
+			"acl":    resource.NewStringProperty(acl),
+		}
+		synthesized = append(synthesized, PulumiResource{
+			PulumiResourceID: PulumiResourceID{
+				// BucketAclV2 imports by "bucket,acl" when the ACL is not the default.
+				ID:   fmt.Sprintf("%s,%s", bucketID, acl),
+				Name: baseName + "-acl",
+				Type: "aws:s3/bucketAclV2:BucketAclV2",
+			},
+			Inputs:  props,
+			Outputs: props,
+		})
+	}
+
+	return synthesized
+}
+
+// inlineVersioningStatus reads the pre-4.0 inline versioning block, reporting the equivalent
+// status of a BucketVersioningV2 resource. Buckets that never enabled versioning return false: the
+// provider records enabled=false for every bucket, and synthesizing a Suspended resource for them
+// would be wrong.
+func inlineVersioningStatus(value interface{}) (string, bool) {
+	blocks, ok := value.([]interface{})
+	if !ok || len(blocks) == 0 {
+		return "", false
+	}
+	block, ok := blocks[0].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	enabled, _ := block["enabled"].(bool)
+	if !enabled {
+		return "", false
+	}
+	return "Enabled", true
+}
+
+// inlineLifecycleRules translates pre-4.0 inline lifecycle_rule blocks into the rule shape of a
+// BucketLifecycleConfigurationV2 resource. Only the stable core of each rule is carried over: id,
+// prefix filter, status and expiration; the first preview reconciles the rest against the live
+// configuration.
+func inlineLifecycleRules(value interface{}) []resource.PropertyValue {
+	blocks, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+	var rules []resource.PropertyValue
+	for _, raw := range blocks {
+		block, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rule := resource.PropertyMap{}
+		if id, _ := block["id"].(string); id != "" {
+			rule["id"] = resource.NewStringProperty(id)
+		}
+		status := "Disabled"
+		if enabled, _ := block["enabled"].(bool); enabled {
+			status = "Enabled"
+		}
+		rule["status"] = resource.NewStringProperty(status)
+		if prefix, _ := block["prefix"].(string); prefix != "" {
+			rule["filter"] = resource.NewObjectProperty(resource.PropertyMap{
+				"prefix": resource.NewStringProperty(prefix),
+			})
+		}
+		if expirations, ok := block["expiration"].([]interface{}); ok && len(expirations) > 0 {
+			if expiration, ok := expirations[0].(map[string]interface{}); ok {
+				if days, ok := expiration["days"].(float64); ok && days > 0 {
+					rule["expiration"] = resource.NewObjectProperty(resource.PropertyMap{
+						"days": resource.NewNumberProperty(days),
+					})
+				}
+			}
+		}
+		rules = append(rules, resource.NewObjectProperty(rule))
+	}
+	return rules
+}
+
+// dropInlineS3SubResourceInputs removes the bucket inputs now owned by synthesized sub-resources,
+// mirroring how inline security group rules yield to separate rule resources: leaving them inline
+// would make the bucket and the sub-resources fight over ownership in the first preview.
+func dropInlineS3SubResourceInputs(inputs resource.PropertyMap) resource.PropertyMap {
+	for _, key := range []resource.PropertyKey{"versionings", "lifecycleRules", "acl", "grants"} {
+		delete(inputs, key)
+	}
+	return inputs
+}
@@ -0,0 +1,110 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hexops/autogold/v2"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge/info"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_awsSplitsS3SubResources(t *testing.T) {
+	t.Parallel()
+
+	t.Run("providers bridging upstream 3.x keep bucket config inline", func(t *testing.T) {
+		t.Parallel()
+		require.False(t, awsSplitsS3SubResources(&ProviderWithMetadata{
+			Provider:         &info.Provider{Name: "aws", Version: "6.20.1"},
+			TerraformAddress: "registry.terraform.io/hashicorp/aws",
+		}))
+	})
+
+	t.Run("providers bridging upstream 4.x and newer split bucket config", func(t *testing.T) {
+		t.Parallel()
+		require.True(t, awsSplitsS3SubResources(&ProviderWithMetadata{
+			Provider:         &info.Provider{Name: "aws", Version: "7.37.0"},
+			TerraformAddress: "registry.terraform.io/hashicorp/aws",
+		}))
+	})
+
+	t.Run("unknown upstream versions are assumed current", func(t *testing.T) {
+		t.Parallel()
+		require.True(t, awsSplitsS3SubResources(&ProviderWithMetadata{
+			Provider:         &info.Provider{Name: "aws", Version: "0.0.1-dev"},
+			TerraformAddress: "registry.example.com/acme/forked-aws",
+		}))
+	})
+}
+
+func Test_synthesizeS3SubResources(t *testing.T) {
+	t.Parallel()
+
+	t.Run("inline shape synthesizes the split resources", func(t *testing.T) {
+		t.Parallel()
+		res := &tfjson.StateResource{
+			Address: "aws_s3_bucket.logs",
+			Type:    "aws_s3_bucket",
+			Name:    "logs",
+			AttributeValues: map[string]interface{}{
+				"id":  "logs-bucket",
+				"acl": "public-read",
+				"versioning": []interface{}{
+					map[string]interface{}{"enabled": true, "mfa_delete": false},
+				},
+				"lifecycle_rule": []interface{}{
+					map[string]interface{}{
+						"id":      "expire-old",
+						"enabled": true,
+						"prefix":  "tmp/",
+						"expiration": []interface{}{
+							map[string]interface{}{"days": float64(30)},
+						},
+					},
+				},
+			},
+		}
+		autogold.ExpectFile(t, synthesizeS3SubResources(res))
+	})
+
+	t.Run("split shape synthesizes nothing", func(t *testing.T) {
+		t.Parallel()
+		// A state written by a 4.x+ provider: the bucket carries only defaults inline, with any
+		// versioning or lifecycle configuration living in its own aws_s3_bucket_* resource.
+		res := &tfjson.StateResource{
+			Address: "aws_s3_bucket.logs",
+			Type:    "aws_s3_bucket",
+			Name:    "logs",
+			AttributeValues: map[string]interface{}{
+				"id":             "logs-bucket",
+				"acl":            "private",
+				"versioning":     []interface{}{map[string]interface{}{"enabled": false, "mfa_delete": false}},
+				"lifecycle_rule": []interface{}{},
+			},
+		}
+		require.Empty(t, synthesizeS3SubResources(res))
+	})
+
+	t.Run("non-bucket resources synthesize nothing", func(t *testing.T) {
+		t.Parallel()
+		require.Empty(t, synthesizeS3SubResources(&tfjson.StateResource{
+			Address:         "aws_instance.web",
+			Type:            "aws_instance",
+			AttributeValues: map[string]interface{}{"id": "i-123"},
+		}))
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// securityGroupRuleTypes are the Terraform resource types that manage security group rules as
+// separate resources rather than inline ingress/egress blocks.
+var securityGroupRuleTypes = map[string]bool{
+	"aws_security_group_rule":             true,
+	"aws_vpc_security_group_ingress_rule": true,
+	"aws_vpc_security_group_egress_rule":  true,
+}
+
+// securityGroupsWithSeparateRules returns the IDs of security groups whose rules are managed by
+// separate rule resources somewhere in the state. Terraform allows mixing inline ingress/egress
+// blocks with separate rule resources, but the state of an aws_security_group always reflects all
+// of its rules inline; translating those inline rules as inputs alongside the separately imported
+// rule resources makes the two fight over ownership in the first preview.
+func securityGroupsWithSeparateRules(tfState *tfjson.State) map[string]bool {
+	groups := map[string]bool{}
+	_ = tofu.VisitResources(tfState, func(res *tfjson.StateResource) error {
+		if !securityGroupRuleTypes[res.Type] {
+			return nil
+		}
+		if id, ok := res.AttributeValues["security_group_id"].(string); ok && id != "" {
+			groups[id] = true
+		}
+		return nil
+	}, nil) // Use default options (skips data sources)
+	return groups
+}
+
+// dropSeparatelyManagedSGRules removes the inline ingress/egress inputs of an aws_security_group
+// whose rules are managed by separate rule resources, leaving rule ownership with the imported
+// rule resources. Groups with genuinely inline rules keep them inline. Only inputs are dropped;
+// the rules stay visible in the resource outputs.
+func dropSeparatelyManagedSGRules(
+	res *tfjson.StateResource,
+	inputs resource.PropertyMap,
+	separatelyManaged map[string]bool,
+) resource.PropertyMap {
+	if res.Type != "aws_security_group" {
+		return inputs
+	}
+	id, ok := res.AttributeValues["id"].(string)
+	if !ok || !separatelyManaged[id] {
+		return inputs
+	}
+	for _, key := range []resource.PropertyKey{"ingress", "egress"} {
+		delete(inputs, key)
+	}
+	return inputs
+}
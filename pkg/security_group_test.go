@@ -0,0 +1,102 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// securityGroupTestState builds a state with both rule styles: sg-inline carries genuinely inline
+// rules, while sg-separate has its rules managed by a separate aws_security_group_rule resource.
+func securityGroupTestState() *tfjson.State {
+	return &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{
+					{
+						Address: "aws_security_group.inline",
+						Mode:    tfjson.ManagedResourceMode,
+						Type:    "aws_security_group",
+						Name:    "inline",
+						AttributeValues: map[string]interface{}{
+							"id":      "sg-inline",
+							"ingress": []interface{}{map[string]interface{}{"from_port": float64(443)}},
+						},
+					},
+					{
+						Address: "aws_security_group.separate",
+						Mode:    tfjson.ManagedResourceMode,
+						Type:    "aws_security_group",
+						Name:    "separate",
+						AttributeValues: map[string]interface{}{
+							"id":      "sg-separate",
+							"ingress": []interface{}{map[string]interface{}{"from_port": float64(80)}},
+						},
+					},
+					{
+						Address: "aws_security_group_rule.http",
+						Mode:    tfjson.ManagedResourceMode,
+						Type:    "aws_security_group_rule",
+						Name:    "http",
+						AttributeValues: map[string]interface{}{
+							"id":                "sgrule-1",
+							"security_group_id": "sg-separate",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_securityGroupsWithSeparateRules(t *testing.T) {
+	t.Parallel()
+
+	groups := securityGroupsWithSeparateRules(securityGroupTestState())
+	assert.Equal(t, map[string]bool{"sg-separate": true}, groups)
+}
+
+func Test_dropSeparatelyManagedSGRules(t *testing.T) {
+	t.Parallel()
+
+	state := securityGroupTestState()
+	groups := securityGroupsWithSeparateRules(state)
+	resources := state.Values.RootModule.Resources
+
+	inputs := func() resource.PropertyMap {
+		return resource.PropertyMap{
+			"ingress": resource.NewProperty("rules"),
+			"name":    resource.NewProperty("sg"),
+		}
+	}
+
+	// Genuinely inline rules stay inline.
+	kept := dropSeparatelyManagedSGRules(resources[0], inputs(), groups)
+	require.Contains(t, kept, resource.PropertyKey("ingress"))
+
+	// Separately managed rules are dropped from the group's inputs.
+	dropped := dropSeparatelyManagedSGRules(resources[1], inputs(), groups)
+	require.NotContains(t, dropped, resource.PropertyKey("ingress"))
+	require.Contains(t, dropped, resource.PropertyKey("name"))
+
+	// Non-security-group resources are untouched.
+	other := dropSeparatelyManagedSGRules(resources[2], inputs(), groups)
+	require.Contains(t, other, resource.PropertyKey("ingress"))
+}
@@ -15,19 +15,22 @@
 package pkg
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"maps"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 
-	"github.com/google/uuid"
 	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge"
 	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/bridge"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/migration"
 	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/providermap"
 	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
@@ -54,61 +57,319 @@ func TranslateAndWriteState(
 	outputFilePath string,
 	requiredProvidersOutputFilePath string,
 	strict bool,
+	strictProviderVersion bool,
 ) error {
-	tfState, err := tofu.LoadTerraformState(ctx, tofu.LoadTerraformStateOptions{
-		ProjectDir: tfDir,
+	res, err := translateProject(ctx, tfDir, pulumiProgramDir, strict, strictProviderVersion)
+	if err != nil {
+		return err
+	}
+
+	if reportDryRun(res, outputFilePath) {
+		return nil
+	}
+
+	ok, err := confirmWrite(res, outputFilePath)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Aborted; no files written.")
+		return nil
+	}
+
+	return TimePhase("file write", func() error {
+		if err := writeStackExportFile(outputFilePath, res.Export); err != nil {
+			return err
+		}
+
+		if requiredProvidersOutputFilePath != "" {
+			if err := writeRequiredProviders(requiredProvidersOutputFilePath, res.RequiredProviders); err != nil {
+				return err
+			}
+		}
+		return writePluginsYAML(res.RequiredProviders)
 	})
+}
+
+// TranslateAndWriteOutputDir runs the same translation as TranslateAndWriteState but writes every
+// output into a single directory with consistent names: state.json (the stack export),
+// required-providers.json, skipped.json (resources that failed to translate) and summary.txt.
+// This saves callers from juggling multiple output paths.
+func TranslateAndWriteOutputDir(
+	ctx context.Context,
+	tfDir string,
+	pulumiProgramDir string,
+	outputDir string,
+	strict bool,
+	strictProviderVersion bool,
+) error {
+	res, err := translateProject(ctx, tfDir, pulumiProgramDir, strict, strictProviderVersion)
 	if err != nil {
 		return err
 	}
 
-	providerVersions, err := tofu.GetProviderVersions(ctx, tfDir)
+	if reportDryRun(res, filepath.Join(outputDir, "state.json")) {
+		return nil
+	}
+
+	ok, err := confirmWrite(res, filepath.Join(outputDir, "state.json"))
 	if err != nil {
-		// Log the error but don't fail - provider versions are optional
-		fmt.Fprintf(os.Stderr, "Warning: failed to extract provider versions: %v\n", err)
-		providerVersions = tofu.TofuVersionOutput{}
+		return err
+	}
+	if !ok {
+		fmt.Fprintln(os.Stderr, "Aborted; no files written.")
+		return nil
+	}
+
+	if err := os.MkdirAll(outputDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	if err := writeStackExportFile(filepath.Join(outputDir, "state.json"), res.Export); err != nil {
+		return err
+	}
+	if err := writeRequiredProviders(filepath.Join(outputDir, "required-providers.json"), res.RequiredProviders); err != nil {
+		return err
+	}
+	if err := writePluginsYAML(res.RequiredProviders); err != nil {
+		return err
 	}
 
-	res, err := TranslateState(ctx, tfState, providerVersions.ProviderSelections, pulumiProgramDir)
+	skipped := res.ErrorMessages
+	if skipped == nil {
+		skipped = []ErroredResource{}
+	}
+	bytes, err := json.Marshal(skipped)
 	if err != nil {
+		return fmt.Errorf("failed to marshal skipped resources: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, "skipped.json"), bytes, 0o600); err != nil {
+		return fmt.Errorf("failed to write skipped resources: %w", err)
+	}
+
+	summary := fmt.Sprintf(
+		"Translated %d resources into state.json\n"+
+			"Required %d Pulumi providers (see required-providers.json)\n"+
+			"Skipped %d resources that failed to translate (see skipped.json)\n",
+		len(res.Export.Deployment.Resources), len(res.RequiredProviders), len(skipped))
+	if err := os.WriteFile(filepath.Join(outputDir, "summary.txt"), []byte(summary), 0o600); err != nil {
+		return fmt.Errorf("failed to write summary: %w", err)
+	}
+	return nil
+}
+
+// translateProject loads the Terraform state for a project directory and translates it, reporting
+// warnings and per-resource translation failures on stderr.
+func translateProject(
+	ctx context.Context,
+	tfDir string,
+	pulumiProgramDir string,
+	strict bool,
+	strictProviderVersion bool,
+) (*TranslateStateResult, error) {
+	// tfDir may name a project directory or a state file directly; provider versions and provider
+	// configuration can only be extracted when there is a project to inspect.
+	loadOpts, err := tofu.StateInputOptions(tfDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var tfState *tfjson.State
+	err = TimePhase("state load", func() error {
+		tfState, err = tofu.LoadTerraformState(ctx, loadOpts)
 		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	providerVersions := tofu.TofuVersionOutput{}
+	if loadOpts.ProjectDir != "" {
+		providerVersions, err = tofu.GetProviderVersions(ctx, tfDir)
+		if err != nil {
+			// Log the error but don't fail - provider versions are optional
+			fmt.Fprintf(os.Stderr, "Warning: failed to extract provider versions: %v\n", err)
+			providerVersions = tofu.TofuVersionOutput{}
+		}
+	}
+
+	issues, err := CheckProviderVersionCompatibility(tfState, providerVersions.ProviderSelections)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check provider version compatibility: %w", err)
+	}
+	for _, issue := range issues {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", issue)
+	}
+	if strictProviderVersion && len(issues) > 0 {
+		return nil, fmt.Errorf("state was written by provider versions newer than the bridged providers support " +
+			"(see warnings above); re-run without --strict-provider-version to translate anyway")
+	}
+
+	var providerConfigs map[string]resource.PropertyMap
+	if loadOpts.ProjectDir != "" {
+		_ = TimePhase("provider config extraction", func() error {
+			providerConfigs, err = ExtractProviderConfigs(tfDir)
+			if err != nil {
+				// Log the error but don't fail - provider configuration is optional
+				fmt.Fprintf(os.Stderr, "Warning: failed to extract provider configuration: %v\n", err)
+				providerConfigs = nil
+			}
+			return nil
+		})
+	}
+
+	res, err := TranslateState(ctx, tfState, providerVersions.ProviderSelections, providerConfigs, pulumiProgramDir)
+	if err != nil {
+		return nil, err
 	}
 	if len(res.ErrorMessages) > 0 {
 		for _, errorMessage := range res.ErrorMessages {
 			fmt.Fprintf(os.Stderr, "failed to translate resource %s with type %s and provider %s: %v\n", errorMessage.ResourceName, errorMessage.ResourceType, errorMessage.ResourceProvider, errorMessage.ErrorMessage)
 		}
 		if strict {
-			return fmt.Errorf("failed to translate state: %w", errors.New("failed to translate state for some resources"))
+			return nil, fmt.Errorf("failed to translate state: %w", errors.New("failed to translate state for some resources"))
+		}
+	}
+	fmt.Fprint(os.Stderr, formatResourceTypeStats(resourceTypeStats(res)))
+	return res, nil
+}
+
+// writeStackExportFile streams the stack export to the given file path. The path "-" writes to
+// stdout instead, for piping into other tools; progress messages all go to stderr so the stream
+// stays pure JSON.
+func writeStackExportFile(path string, export StackExport) error {
+	if path == "-" {
+		if err := writeStackExport(os.Stdout, export); err != nil {
+			return fmt.Errorf("failed to write stack export: %w", err)
 		}
+		_, err := fmt.Println()
+		return err
 	}
-	bytes, err := json.Marshal(res.Export)
+	outputFile, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
 	if err != nil {
-		return fmt.Errorf("failed to marshal stack export: %w", err)
+		return fmt.Errorf("failed to create stack export file: %w", err)
+	}
+	err = writeStackExport(outputFile, export)
+	if closeErr := outputFile.Close(); err == nil {
+		err = closeErr
 	}
-	err = os.WriteFile(outputFilePath, bytes, 0o600)
 	if err != nil {
 		return fmt.Errorf("failed to write stack export: %w", err)
 	}
+	return nil
+}
+
+// writeRequiredProviders writes the recommended Pulumi plugin requirements to the given file path.
+func writeRequiredProviders(path string, providers []*ProviderWithMetadata) error {
+	requiredProviders := make([]RequiredProviderExport, 0, len(providers))
+	for _, provider := range providers {
+		requiredProviders = append(requiredProviders,
+			RequiredProviderExport{Name: requiredProviderName(provider), Version: provider.Version})
+	}
+	bytes, err := json.Marshal(requiredProviders)
+	if err != nil {
+		return fmt.Errorf("failed to marshal required providers: %w", err)
+	}
+	if path == "-" {
+		_, err := fmt.Printf("%s\n", bytes)
+		return err
+	}
+	err = os.WriteFile(path, bytes, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to write required providers: %w", err)
+	}
+	return nil
+}
+
+// writeStackExport streams a stack export to w, writing the resources array element by element so
+// memory stays bounded for deployments with tens of thousands of resources. The output is
+// byte-identical to json.Marshal of the StackExport; the deployment shell is spelled out field by
+// field here, so new apitype.DeploymentV3 fields must be added in both places (the equality test
+// catches a mismatch).
+func writeStackExport(w io.Writer, export StackExport) error {
+	bw := bufio.NewWriter(w)
+
+	writeField := func(name string, value any) error {
+		bytes, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", name, err)
+		}
+		if _, err := fmt.Fprintf(bw, ",%q:", name); err != nil {
+			return err
+		}
+		_, err = bw.Write(bytes)
+		return err
+	}
+
+	deployment := export.Deployment
+	if _, err := bw.WriteString(`{"deployment":{"manifest":`); err != nil {
+		return err
+	}
+	manifest, err := json.Marshal(deployment.Manifest)
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if _, err := bw.Write(manifest); err != nil {
+		return err
+	}
 
-	if requiredProvidersOutputFilePath != "" {
-		requiredProviders := make([]RequiredProviderExport, 0, len(res.RequiredProviders))
-		for _, provider := range res.RequiredProviders {
-			name := provider.Name
-			if provider.IsDynamic {
-				name = formatDynamicProviderName(provider.TerraformAddress)
+	if deployment.SecretsProviders != nil {
+		if err := writeField("secrets_providers", deployment.SecretsProviders); err != nil {
+			return err
+		}
+	}
+
+	if len(deployment.Resources) > 0 {
+		if _, err := bw.WriteString(`,"resources":[`); err != nil {
+			return err
+		}
+		for i, res := range deployment.Resources {
+			if i > 0 {
+				if err := bw.WriteByte(','); err != nil {
+					return err
+				}
+			}
+			bytes, err := json.Marshal(res)
+			if err != nil {
+				return fmt.Errorf("failed to marshal resource %s: %w", res.URN, err)
+			}
+			if _, err := bw.Write(bytes); err != nil {
+				return err
 			}
-			requiredProviders = append(requiredProviders, RequiredProviderExport{Name: name, Version: provider.Version})
 		}
-		bytes, err := json.Marshal(requiredProviders)
-		if err != nil {
-			return fmt.Errorf("failed to marshal required providers: %w", err)
+		if err := bw.WriteByte(']'); err != nil {
+			return err
 		}
-		err = os.WriteFile(requiredProvidersOutputFilePath, bytes, 0o600)
-		if err != nil {
-			return fmt.Errorf("failed to write required providers: %w", err)
+	}
+
+	if len(deployment.PendingOperations) > 0 {
+		if err := writeField("pending_operations", deployment.PendingOperations); err != nil {
+			return err
 		}
 	}
-	return nil
+
+	// The metadata field is tagged omitempty but is a struct, so json.Marshal always emits it.
+	if err := writeField("metadata", deployment.Metadata); err != nil {
+		return err
+	}
+
+	if len(deployment.Snippets) > 0 {
+		if err := writeField("snippets", deployment.Snippets); err != nil {
+			return err
+		}
+	}
+
+	if len(deployment.Extensions) > 0 {
+		if err := writeField("extensions", deployment.Extensions); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(bw, `},"version":%d}`, export.Version); err != nil {
+		return err
+	}
+
+	return bw.Flush()
 }
 
 type TranslateStateResult struct {
@@ -117,18 +378,60 @@ type TranslateStateResult struct {
 	ErrorMessages     []ErroredResource
 }
 
-func TranslateState(ctx context.Context, tfState *tfjson.State, providerVersions map[string]string, pulumiProgramDir string) (*TranslateStateResult, error) {
-	pulumiProviders, err := GetPulumiProvidersForTerraformState(tfState, providerVersions)
+func TranslateState(
+	ctx context.Context,
+	tfState *tfjson.State,
+	providerVersions map[string]string,
+	providerConfigs map[string]resource.PropertyMap,
+	pulumiProgramDir string,
+) (*TranslateStateResult, error) {
+	// A state without managed resources (empty, or only data sources and outputs) has nothing to
+	// migrate; return the target deployment unchanged rather than failing a later step confusingly.
+	if !hasManagedResources(tfState) {
+		fmt.Fprintln(os.Stderr, "no managed resources to migrate")
+		deployment, err := GetDeployment(pulumiProgramDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get deployment: %w", err)
+		}
+		return &TranslateStateResult{
+			Export: StackExport{
+				Deployment: deployment.Deployment,
+				Version:    SupportedStateVersion,
+			},
+		}, nil
+	}
+
+	var pulumiProviders map[providermap.TerraformProviderName]*ProviderWithMetadata
+	err := TimePhase("provider install", func() error {
+		var err error
+		pulumiProviders, err = GetPulumiProvidersForTerraformState(tfState, providerVersions)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	pulumiState, errorMessages, err := convertState(tfState, pulumiProviders)
+	var pulumiState *PulumiState
+	var errorMessages []ErroredResource
+	err = TimePhase("value conversion", func() error {
+		var err error
+		pulumiState, errorMessages, err = convertState(tfState, pulumiProviders, providerConfigs)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert state: %w", err)
 	}
 
-	deployment, err := GetDeployment(pulumiProgramDir)
+	if err := writeProviderConfigDump(pulumiState); err != nil {
+		return nil, err
+	}
+
+	var deployment *DeploymentResult
+	err = TimePhase("deployment export", func() error {
+		var err error
+		deployment, err = GetDeployment(pulumiProgramDir)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get deployment: %w", err)
 	}
@@ -138,18 +441,36 @@ func TranslateState(ctx context.Context, tfState *tfjson.State, providerVersions
 		return nil, fmt.Errorf("failed to insert resources into deployment: %w", err)
 	}
 
-	requiredProviders := slices.Collect(maps.Values(pulumiProviders))
+	loadedProviders := make([]*ProviderWithMetadata, 0, len(pulumiProviders))
+	for _, provider := range pulumiProviders {
+		if provider.LoadError == nil {
+			loadedProviders = append(loadedProviders, provider)
+		}
+	}
+	requiredProviders := dedupeRequiredProviders(loadedProviders)
+	warnEOLProviderVersions(requiredProviders)
 
 	return &TranslateStateResult{
 		Export: StackExport{
 			Deployment: editedDeployment,
-			Version:    3,
+			Version:    SupportedStateVersion,
 		},
 		RequiredProviders: requiredProviders,
 		ErrorMessages:     errorMessages,
 	}, nil
 }
 
+// hasManagedResources reports whether the state carries any managed resources. Data sources and
+// outputs do not count; a state with neither has nothing to migrate.
+func hasManagedResources(tfState *tfjson.State) bool {
+	managed := false
+	_ = tofu.VisitResources(tfState, func(*tfjson.StateResource) error {
+		managed = true
+		return nil
+	}, nil) // Use default options (skips data sources)
+	return managed
+}
+
 type ErroredResource struct {
 	ResourceName     string `json:"resource_name"`
 	ResourceType     string `json:"resource_type"`
@@ -157,23 +478,33 @@ type ErroredResource struct {
 	ErrorMessage     string `json:"error_message"`
 }
 
-func convertState(tfState *tfjson.State, pulumiProviders map[providermap.TerraformProviderName]*ProviderWithMetadata) (*PulumiState, []ErroredResource, error) {
+func convertState(
+	tfState *tfjson.State,
+	pulumiProviders map[providermap.TerraformProviderName]*ProviderWithMetadata,
+	providerConfigs map[string]resource.PropertyMap,
+) (*PulumiState, []ErroredResource, error) {
 	pulumiState := &PulumiState{}
 
 	// TODO[pulumi/pulumi-service#35512]: This assumes one Pulumi provider per Terraform provider.
 	// This means that provider aliases are not supported.
 	providerTable := map[providermap.TerraformProviderName]PulumiResourceID{}
 
-	for tfProviderName, provider := range pulumiProviders {
-		inputs, err := GetProviderInputs(provider.Name)
+	// Emit provider resources in a stable order so the output state is byte-identical across runs;
+	// map iteration order would shuffle them.
+	for _, tfProviderName := range slices.Sorted(maps.Keys(pulumiProviders)) {
+		provider := pulumiProviders[tfProviderName]
+		if provider.LoadError != nil {
+			// The provider failed to load; its resources are reported individually below.
+			continue
+		}
+		inputs, err := GetProviderInputs(provider.PulumiPackageName(), providerConfigs[terraformProviderLocalName(string(tfProviderName))])
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to get provider inputs: %w", err)
 		}
-		uuid := uuid.NewString()
 		providerResource := PulumiResource{
 			PulumiResourceID: PulumiResourceID{
-				ID:   uuid,
-				Type: "pulumi:providers:" + provider.Name,
+				ID:   providerResourceID(provider),
+				Type: "pulumi:providers:" + provider.PulumiPackageName(),
 				Name: "default_" + strings.ReplaceAll(provider.Version, ".", "_"),
 			},
 			Inputs:  inputs,
@@ -186,11 +517,59 @@ func convertState(tfState *tfjson.State, pulumiProviders map[providermap.Terrafo
 
 	errorMessages := []ErroredResource{}
 
-	err := tofu.VisitResources(tfState, func(resource *tfjson.StateResource) error {
+	if tfState.Values != nil {
+		pulumiState.Outputs = convertStateOutputs(tfState.Values.Outputs)
+	}
+
+	excludes, err := loadExcludeList()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	separateRuleSGs := securityGroupsWithSeparateRules(tfState)
+
+	names := newUniqueNamer()
+	err = tofu.VisitResources(tfState, func(resource *tfjson.StateResource) error {
+		if resource.DeposedKey != "" {
+			errorMessages = append(errorMessages, ErroredResource{
+				ResourceName:     resource.Name,
+				ResourceType:     resource.Type,
+				ResourceProvider: resource.ProviderName,
+				ErrorMessage:     deposedSkipMessage(resource.DeposedKey),
+			})
+			return nil
+		}
+		if excludes.excludes(resource.Address) {
+			errorMessages = append(errorMessages, ErroredResource{
+				ResourceName:     resource.Name,
+				ResourceType:     resource.Type,
+				ResourceProvider: resource.ProviderName,
+				ErrorMessage:     "excluded by file",
+			})
+			return nil
+		}
+		if provider := pulumiProviders[providermap.TerraformProviderName(resource.ProviderName)]; provider != nil && provider.LoadError != nil {
+			if failFast {
+				return fmt.Errorf("provider %s failed to load, needed by %s: %w",
+					resource.ProviderName, resource.Address, provider.LoadError)
+			}
+			errorMessages = append(errorMessages, ErroredResource{
+				ResourceName:     resource.Name,
+				ResourceType:     resource.Type,
+				ResourceProvider: resource.ProviderName,
+				ErrorMessage:     fmt.Sprintf("provider %s failed to load: %v", resource.ProviderName, provider.LoadError),
+			})
+			return nil
+		}
 		// Check if we have a Pulumi provider for this Terraform provider.
 		// If not, skip the resource and add it to the error messages.
 		providerLink, ok := providerTable[providermap.TerraformProviderName(resource.ProviderName)]
 		if !ok {
+			if failFast {
+				return fmt.Errorf("no Pulumi provider available for Terraform provider %s "+
+					"(neither statically bridged nor dynamically bridged), needed by %s",
+					resource.ProviderName, resource.Address)
+			}
 			errorMessages = append(errorMessages, ErroredResource{
 				ResourceName:     resource.Name,
 				ResourceType:     resource.Type,
@@ -199,8 +578,11 @@ func convertState(tfState *tfjson.State, pulumiProviders map[providermap.Terrafo
 			})
 			return nil
 		}
-		pulumiResource, err := convertResourceStateExceptProviderLink(resource, pulumiProviders)
+		pulumiResource, err := convertResourceStateExceptProviderLink(resource, pulumiProviders, separateRuleSGs)
 		if err != nil {
+			if failFast {
+				return fmt.Errorf("failed to translate %s: %w", resource.Address, err)
+			}
 			errorMessages = append(errorMessages, ErroredResource{
 				ResourceName:     resource.Name,
 				ResourceType:     resource.Type,
@@ -209,28 +591,87 @@ func convertState(tfState *tfjson.State, pulumiProviders map[providermap.Terrafo
 			})
 			return nil
 		}
+		pulumiResource.Name = names.unique(pulumiResource.Type, pulumiResource.Name)
 		pulumiResource.Provider = &providerLink
+		// Older AWS providers keep S3 bucket configuration inline; map it onto the separate
+		// sub-resources newer providers use so that configuration stays managed after import.
+		var subResources []PulumiResource
+		if resource.Type == "aws_s3_bucket" {
+			provider := pulumiProviders[providermap.TerraformProviderName(resource.ProviderName)]
+			if provider != nil && awsSplitsS3SubResources(provider) {
+				subResources = synthesizeS3SubResources(resource)
+				if len(subResources) > 0 {
+					pulumiResource.Inputs = dropInlineS3SubResourceInputs(pulumiResource.Inputs)
+				}
+			}
+		}
 		pulumiState.Resources = append(pulumiState.Resources, pulumiResource)
+		for _, sub := range subResources {
+			sub.Name = names.unique(sub.Type, sub.Name)
+			sub.Provider = &providerLink
+			pulumiState.Resources = append(pulumiState.Resources, sub)
+		}
 		return nil
-	}, &tofu.VisitOptions{})
+	}, &tofu.VisitOptions{IncludeDeposed: includeDeposed})
 	if err != nil {
 		return nil, errorMessages, fmt.Errorf("failed to visit resources: %w", err)
 	}
 
+	// terraform_remote_state data sources have a direct Pulumi equivalent in StackReference;
+	// either synthesize one per data source or report them distinctly in the skipped list.
+	for _, remote := range remoteStateDataSources(tfState) {
+		if !emitStackReferences {
+			errorMessages = append(errorMessages, ErroredResource{
+				ResourceName:     remote.Name,
+				ResourceType:     remote.Type,
+				ResourceProvider: remote.ProviderName,
+				ErrorMessage:     remoteStateSkipMessage(),
+			})
+			continue
+		}
+		ref := synthesizeStackReference(remote)
+		ref.Name = names.unique(ref.Type, ref.Name)
+		fmt.Fprintf(os.Stderr, "Translated %s into StackReference %q referencing stack %q; "+
+			"verify the stack name before importing\n", remote.Address, ref.Name, ref.ID)
+		pulumiState.StackReferences = append(pulumiState.StackReferences, ref)
+	}
+
 	return pulumiState, errorMessages, nil
 }
 
+// convertStateOutputs translates Terraform root-module outputs into Pulumi stack outputs.
+// Outputs marked `sensitive = true` become Pulumi secrets.
+func convertStateOutputs(outputs map[string]*tfjson.StateOutput) resource.PropertyMap {
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	result := resource.PropertyMap{}
+	for name, output := range outputs {
+		if output == nil {
+			continue
+		}
+		value := resource.NewPropertyValue(output.Value)
+		if output.Sensitive {
+			value = resource.MakeSecret(value)
+		}
+		result[resource.PropertyKey(name)] = value
+	}
+	return result
+}
+
 func convertResourceStateExceptProviderLink(
 	res *tfjson.StateResource,
 	pulumiProviders map[providermap.TerraformProviderName]*ProviderWithMetadata,
+	separateRuleSGs map[string]bool,
 ) (PulumiResource, error) {
 	prov, ok := pulumiProviders[providermap.TerraformProviderName(res.ProviderName)]
 	if !ok {
 		return PulumiResource{}, fmt.Errorf("no Pulumi provider found for Terraform provider: %s", res.ProviderName)
 	}
-	shimResource := prov.P.ResourcesMap().Get(res.Type)
-	if shimResource == nil {
-		return PulumiResource{}, fmt.Errorf("no resource type found for Terraform resource: %s", res.Type)
+	shimResource, effectiveType, err := resolveShimResource(prov, res.Type)
+	if err != nil {
+		return PulumiResource{}, err
 	}
 
 	ctyType := bridge.ImpliedType(shimResource.Schema(), true)
@@ -239,6 +680,12 @@ func convertResourceStateExceptProviderLink(
 		return PulumiResource{}, fmt.Errorf("failed to convert resource to CTY value: %w", err)
 	}
 
+	jsonDocumentAttrs, err := loadJSONDocumentAttributes()
+	if err != nil {
+		return PulumiResource{}, fmt.Errorf("failed to load JSON document attributes: %w", err)
+	}
+	ctyValue = normalizeJSONDocuments(ctyValue, jsonDocumentAttrs[terraformProviderLocalName(res.ProviderName)])
+
 	var sensitivePaths []cty.Path
 	if res.SensitiveValues != nil {
 		sensitiveValues := map[string]interface{}{}
@@ -249,11 +696,11 @@ func convertResourceStateExceptProviderLink(
 		sensitivePaths = tofu.SensitiveObjToCtyPath(sensitiveValues)
 	}
 
-	pulumiTypeToken, err := bridge.PulumiTypeToken(res.Type, prov.Provider)
+	pulumiTypeToken, err := bridge.PulumiTypeToken(effectiveType, prov.Provider)
 	if err != nil {
 		return PulumiResource{}, fmt.Errorf("failed to get Pulumi type token: %w", err)
 	}
-	resourceInfo := prov.Resources[res.Type]
+	resourceInfo := prov.Resources[effectiveType]
 	props, err := ConvertTFValueToPulumiValue(ctyValue, shimResource, resourceInfo, sensitivePaths)
 	if err != nil {
 		return PulumiResource{}, fmt.Errorf("failed to convert value to Pulumi value: %w", err)
@@ -264,9 +711,22 @@ func convertResourceStateExceptProviderLink(
 		return PulumiResource{}, fmt.Errorf("failed to extract inputs from outputs: %w", err)
 	}
 
+	denyList, err := loadInputDenyList()
+	if err != nil {
+		return PulumiResource{}, fmt.Errorf("failed to load input deny list: %w", err)
+	}
+	inputs = denyList.filterInputs(string(pulumiTypeToken), inputs)
+	inputs = DropOversizedInputs(inputs, res.Address)
+	inputs = dropSeparatelyManagedSGRules(res, inputs, separateRuleSGs)
+
+	importID, err := ResourceImportID(resourceInfo, props, res.Address)
+	if err != nil {
+		return PulumiResource{}, err
+	}
+
 	return PulumiResource{
 		PulumiResourceID: PulumiResourceID{
-			ID:   props["id"].StringValue(),
+			ID:   importID,
 			Name: PulumiNameFromTerraformAddress(res.Address, res.Type),
 			Type: string(pulumiTypeToken),
 		},
@@ -296,21 +756,25 @@ func formatDynamicProviderName(tfAddr string) string {
 //   - Submodule: module.<module_name>.<resource_type>.<name> e.g., "module.s3_bucket.aws_s3_bucket.this"
 //   - Nested: module.<mod1>.module.<mod2>.<resource_type>.<name>
 //
-// We extract the module path and resource name (excluding the type) and join them with underscores.
+// By default we extract the module path and resource name (excluding the type) and join them with
+// underscores; the configured naming strategy can alter this (see SetNamingStrategy).
 func PulumiNameFromTerraformAddress(address, resourceType string) string {
-	parts := strings.Split(address, ".")
+	return applyNamingStrategy(address, resourceType)
+}
 
-	var nameParts []string
-	for i := 0; i < len(parts); i++ {
-		if parts[i] == resourceType {
-			nameParts = append(nameParts, parts[i+1:]...)
-			break
-		}
-		if parts[i] == "module" && i+1 < len(parts) {
-			nameParts = append(nameParts, parts[i+1])
-			i++
-		}
+// PulumiNameWithInstanceKey derives a map-friendly Pulumi name for a keyed Terraform instance by
+// appending the for_each key or count index with a hyphen: aws_instance.web["a"] becomes "web-a"
+// and aws_instance.web[0] becomes "web-0". This keeps the semantic meaning of for_each keys
+// visible in logical names so a Pulumi program using a map over the same keys lines up with the
+// migrated state. Returns "" for addresses without an instance key.
+func PulumiNameWithInstanceKey(address, resourceType string) string {
+	key := migration.TerraformInstanceKey(address)
+	if key == "" {
+		return ""
 	}
-
-	return strings.Join(nameParts, "_")
+	name := PulumiNameFromTerraformAddress(address, resourceType)
+	if idx := strings.Index(name, "["); idx >= 0 {
+		name = name[:idx]
+	}
+	return name + "-" + key
 }
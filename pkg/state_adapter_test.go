@@ -15,11 +15,20 @@
 package pkg
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"os"
 	"testing"
+	"time"
 
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hexops/autogold/v2"
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/tfbridge/info"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/providermap"
 	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 	"github.com/stretchr/testify/require"
@@ -118,7 +127,7 @@ func translateStateFromJson(ctx context.Context, tfStateJson string, pulumiProgr
 		return nil, err
 	}
 	// When loading from JSON, we don't have provider versions
-	return TranslateState(ctx, tfState, nil, pulumiProgramDir)
+	return TranslateState(ctx, tfState, nil, nil, pulumiProgramDir)
 }
 
 func Test_convertState_simple(t *testing.T) {
@@ -133,7 +142,7 @@ func Test_convertState_simple(t *testing.T) {
 	pulumiProviders, err := GetPulumiProvidersForTerraformState(tfState, nil)
 	require.NoError(t, err, "failed to get Pulumi providers")
 
-	pulumiState, errorMessages, err := convertState(tfState, pulumiProviders)
+	pulumiState, errorMessages, err := convertState(tfState, pulumiProviders, nil)
 	require.NoError(t, err, "failed to convert state")
 	require.Equal(t, 0, len(errorMessages), "expected no error messages")
 
@@ -160,7 +169,7 @@ func Test_convertState_multi_provider(t *testing.T) {
 	pulumiProviders, err := GetPulumiProvidersForTerraformState(tfState, nil)
 	require.NoError(t, err, "failed to get Pulumi providers")
 
-	pulumiState, errorMessages, err := convertState(tfState, pulumiProviders)
+	pulumiState, errorMessages, err := convertState(tfState, pulumiProviders, nil)
 	require.NoError(t, err, "failed to convert state")
 	require.Equal(t, 0, len(errorMessages), "expected no error messages")
 
@@ -215,7 +224,7 @@ func Test_convertState_corrupted_state(t *testing.T) {
 	pulumiProviders, err := GetPulumiProvidersForTerraformState(tfState, nil)
 	require.NoError(t, err, "failed to get Pulumi providers")
 
-	_, errorMessages, err := convertState(tfState, pulumiProviders)
+	_, errorMessages, err := convertState(tfState, pulumiProviders, nil)
 	require.NoError(t, err, "failed to convert state")
 	require.Equal(t, 1, len(errorMessages), "expected 1 error message")
 	require.Equal(t, "password", errorMessages[0].ResourceName)
@@ -238,7 +247,7 @@ func Test_convertState_unknown_provider(t *testing.T) {
 
 	require.Len(t, pulumiProviders, 1, "should only have 1 provider (random)")
 
-	pulumiState, errorMessages, err := convertState(tfState, pulumiProviders)
+	pulumiState, errorMessages, err := convertState(tfState, pulumiProviders, nil)
 	require.NoError(t, err, "failed to convert state")
 
 	require.Len(t, errorMessages, 1, "expected 1 error message for unknown_resource")
@@ -253,6 +262,112 @@ func Test_convertState_unknown_provider(t *testing.T) {
 	require.Equal(t, "random:index/randomString:RandomString", pulumiState.Resources[0].PulumiResourceID.Type)
 }
 
+func Test_convertState_fail_fast(t *testing.T) {
+	// Mutates the package-level fail-fast setting, so no t.Parallel.
+	SetFailFast(true)
+	defer SetFailFast(false)
+
+	tfState := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{{
+					Address:      "unknown_resource.example",
+					Type:         "unknown_resource",
+					Name:         "example",
+					Mode:         tfjson.ManagedResourceMode,
+					ProviderName: "registry.terraform.io/hashicorp/unknown",
+				}},
+			},
+		},
+	}
+
+	_, _, err := convertState(tfState, nil, nil)
+	require.ErrorContains(t, err, "no Pulumi provider available")
+	require.ErrorContains(t, err, "unknown_resource.example")
+}
+
+func Test_convertState_provider_ordering(t *testing.T) {
+	t.Parallel()
+
+	// Provider resources must come out sorted by Terraform provider name, not in map iteration
+	// order, so state.json is byte-stable across runs.
+	pulumiProviders := map[providermap.TerraformProviderName]*ProviderWithMetadata{
+		"registry.terraform.io/hashicorp/tls": {
+			Provider: &info.Provider{Name: "tls", Version: "5.0.1"},
+		},
+		"registry.terraform.io/hashicorp/random": {
+			Provider: &info.Provider{Name: "random", Version: "4.16.3"},
+		},
+		"registry.terraform.io/hashicorp/azurerm": {
+			Provider:   &info.Provider{Name: "azurerm", Version: "6.25.0"},
+			PulumiName: "azure",
+		},
+	}
+
+	pulumiState, errorMessages, err := convertState(&tfjson.State{}, pulumiProviders, nil)
+	require.NoError(t, err, "failed to convert state")
+	require.Empty(t, errorMessages)
+
+	autogold.ExpectFile(t, pulumiState.Providers)
+}
+
+func Test_convertState_provider_load_error(t *testing.T) {
+	t.Parallel()
+
+	// A provider recorded with a LoadError (set under --continue-on-provider-error) contributes no
+	// provider resource; its resources land in the skipped list with the underlying error.
+	pulumiProviders := map[providermap.TerraformProviderName]*ProviderWithMetadata{
+		"registry.terraform.io/exotic/broken": {
+			TerraformAddress: "registry.terraform.io/exotic/broken",
+			LoadError:        errors.New("failed to install provider broken: no such plugin"),
+		},
+	}
+
+	tfState := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{{
+					Address:      "broken_thing.example",
+					Type:         "broken_thing",
+					Name:         "example",
+					Mode:         tfjson.ManagedResourceMode,
+					ProviderName: "registry.terraform.io/exotic/broken",
+				}},
+			},
+		},
+	}
+
+	pulumiState, errorMessages, err := convertState(tfState, pulumiProviders, nil)
+	require.NoError(t, err, "failed to convert state")
+
+	require.Empty(t, pulumiState.Providers, "a failed provider must not be emitted")
+	require.Empty(t, pulumiState.Resources)
+	require.Len(t, errorMessages, 1)
+	require.Equal(t, "example", errorMessages[0].ResourceName)
+	require.Contains(t, errorMessages[0].ErrorMessage, "failed to load")
+	require.Contains(t, errorMessages[0].ErrorMessage, "no such plugin")
+}
+
+func Test_convertState_pulumi_package_name(t *testing.T) {
+	t.Parallel()
+
+	// The azure bridge metadata names the provider "azurerm"; the emitted provider resource must
+	// carry the Pulumi package name in its token.
+	pulumiProviders := map[providermap.TerraformProviderName]*ProviderWithMetadata{
+		"registry.terraform.io/hashicorp/azurerm": {
+			Provider:   &info.Provider{Name: "azurerm", Version: "6.25.0"},
+			PulumiName: "azure",
+		},
+	}
+
+	pulumiState, errorMessages, err := convertState(&tfjson.State{}, pulumiProviders, nil)
+	require.NoError(t, err, "failed to convert state")
+	require.Empty(t, errorMessages)
+
+	require.Len(t, pulumiState.Providers, 1, "expected 1 provider")
+	require.Equal(t, "pulumi:providers:azure", pulumiState.Providers[0].PulumiResourceID.Type)
+}
+
 func TestFormatDynamicProviderName(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -338,6 +453,95 @@ func TestPulumiNameFromTerraformAddress(t *testing.T) {
 	}
 }
 
+func Test_convertStateOutputs(t *testing.T) {
+	t.Parallel()
+
+	outputs := map[string]*tfjson.StateOutput{
+		"endpoint": {
+			Value: "https://example.com",
+		},
+		"db_password": {
+			Value:     "hunter2",
+			Sensitive: true,
+		},
+	}
+
+	converted := convertStateOutputs(outputs)
+	require.Len(t, converted, 2)
+
+	require.Equal(t, "https://example.com", converted["endpoint"].StringValue())
+
+	password := converted["db_password"]
+	require.True(t, password.IsSecret(), "sensitive output should be a secret")
+	require.Equal(t, "hunter2", password.SecretValue().Element.StringValue())
+}
+
+func Test_writeStackExport(t *testing.T) {
+	t.Parallel()
+
+	check := func(t *testing.T, export StackExport) {
+		expected, err := json.Marshal(export)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, writeStackExport(&buf, export))
+		require.Equal(t, string(expected), buf.String(),
+			"streamed output must be byte-identical to json.Marshal")
+	}
+
+	t.Run("empty deployment", func(t *testing.T) {
+		t.Parallel()
+		check(t, StackExport{Version: 3})
+	})
+
+	t.Run("deployment with resources and manifest", func(t *testing.T) {
+		t.Parallel()
+
+		check(t, StackExport{
+			Version: 3,
+			Deployment: apitype.DeploymentV3{
+				Manifest: apitype.ManifestV1{
+					Time:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+					Magic:   "magic",
+					Version: "3.0.0",
+				},
+				Resources: []apitype.ResourceV3{
+					{
+						URN:  resource.URN("urn:pulumi:dev::proj::pulumi:pulumi:Stack::proj-dev"),
+						Type: tokens.Type("pulumi:pulumi:Stack"),
+					},
+					{
+						URN:  resource.URN("urn:pulumi:dev::proj::aws:s3/bucket:Bucket::my-bucket"),
+						Type: tokens.Type("aws:s3/bucket:Bucket"),
+						Outputs: map[string]interface{}{
+							"bucket": "my-bucket",
+							"html":   "<script>&</script>",
+						},
+					},
+				},
+			},
+		})
+	})
+
+	t.Run("deployment with pending operations", func(t *testing.T) {
+		t.Parallel()
+
+		check(t, StackExport{
+			Version: 3,
+			Deployment: apitype.DeploymentV3{
+				PendingOperations: []apitype.OperationV2{
+					{
+						Resource: apitype.ResourceV3{
+							URN: resource.URN("urn:pulumi:dev::proj::aws:s3/bucket:Bucket::pending"),
+						},
+						Type: apitype.OperationTypeCreating,
+					},
+				},
+			},
+		})
+	})
+}
+
 func createPulumiStack(t *testing.T) string {
 	dir, err := os.MkdirTemp("", "pulumi-stack-")
 	require.NoError(t, err)
@@ -347,3 +551,53 @@ func createPulumiStack(t *testing.T) string {
 	_ = runCommand(t, dir, "pulumi", "up", "--yes")
 	return dir
 }
+
+func Test_hasManagedResources(t *testing.T) {
+	t.Parallel()
+
+	t.Run("a state with only data sources and outputs has nothing to migrate", func(t *testing.T) {
+		t.Parallel()
+		tfState := &tfjson.State{
+			Values: &tfjson.StateValues{
+				RootModule: &tfjson.StateModule{
+					Resources: []*tfjson.StateResource{
+						{
+							Address: "data.aws_ami.ubuntu",
+							Mode:    tfjson.DataResourceMode,
+							Type:    "aws_ami",
+							Name:    "ubuntu",
+						},
+					},
+				},
+				Outputs: map[string]*tfjson.StateOutput{
+					"ami_id": {Value: "ami-123"},
+				},
+			},
+		}
+		require.False(t, hasManagedResources(tfState))
+	})
+
+	t.Run("an empty state has nothing to migrate", func(t *testing.T) {
+		t.Parallel()
+		require.False(t, hasManagedResources(&tfjson.State{}))
+	})
+
+	t.Run("managed resources are detected", func(t *testing.T) {
+		t.Parallel()
+		tfState := &tfjson.State{
+			Values: &tfjson.StateValues{
+				RootModule: &tfjson.StateModule{
+					Resources: []*tfjson.StateResource{
+						{
+							Address: "aws_s3_bucket.logs",
+							Mode:    tfjson.ManagedResourceMode,
+							Type:    "aws_s3_bucket",
+							Name:    "logs",
+						},
+					},
+				},
+			},
+		}
+		require.True(t, hasManagedResources(tfState))
+	})
+}
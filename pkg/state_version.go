@@ -0,0 +1,40 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// SupportedStateVersion is the deployment schema version of the stack exports this tool emits. It
+// tracks apitype.DeploymentSchemaVersionCurrent of the Pulumi SDK the tool is built against; when
+// Pulumi introduces a DeploymentV4 the translation has to be ported before a newer version can be
+// offered here.
+const SupportedStateVersion = apitype.DeploymentSchemaVersionCurrent
+
+// ValidateStateVersion checks a requested --state-version against what the tool can emit, so an
+// unsupported request fails before any translation work instead of producing a file the target
+// Pulumi CLI rejects on import.
+func ValidateStateVersion(version int) error {
+	if version == SupportedStateVersion {
+		return nil
+	}
+	return fmt.Errorf(
+		"unsupported state version %d: this tool emits deployment schema version %d; "+
+			"re-run without --state-version, or upgrade the tool once it supports version %d",
+		version, SupportedStateVersion, version)
+}
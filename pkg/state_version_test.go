@@ -0,0 +1,35 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateStateVersion(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateStateVersion(3))
+
+	err := ValidateStateVersion(4)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported state version 4")
+
+	err = ValidateStateVersion(2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unsupported state version 2")
+}
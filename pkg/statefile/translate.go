@@ -19,7 +19,10 @@ package statefile
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log/slog"
+	"sort"
 
 	"github.com/pulumi/opentofu/addrs"
 	"github.com/pulumi/opentofu/states"
@@ -50,14 +53,29 @@ func TranslateResourceInstance(
 	resourceType := res.Addr.Resource.Type
 	shimResource := provider.P.ResourcesMap().Get(resourceType)
 	if shimResource == nil {
-		return pkg.PulumiResource{}, fmt.Errorf("no resource type found for Terraform resource: %s", resourceType)
+		return pkg.PulumiResource{}, fmt.Errorf("resource type %s removed in provider %s version %s",
+			resourceType, provider.Name, provider.Version)
 	}
 
 	// Convert AttrsJSON directly to cty.Value using the schema type
 	ctyType := bridge.ImpliedType(shimResource.Schema(), true)
 	ctyValue, err := ctyjson.Unmarshal(instance.Current.AttrsJSON, ctyType)
 	if err != nil {
-		return pkg.PulumiResource{}, fmt.Errorf("failed to unmarshal attrs JSON: %w", err)
+		// States from resources that used provisioner/connection blocks can carry transient
+		// attributes the provider schema doesn't know about; drop unknown attributes and retry
+		// before giving up on the resource.
+		filtered, dropped, filterErr := filterUnknownAttributes(instance.Current.AttrsJSON, ctyType)
+		if filterErr == nil && len(dropped) > 0 {
+			if retried, retryErr := ctyjson.Unmarshal(filtered, ctyType); retryErr == nil {
+				slog.Warn("Dropped attributes not present in the provider schema",
+					slog.String("address", res.Addr.Instance(key).String()),
+					slog.Any("attributes", dropped))
+				ctyValue, err = retried, nil
+			}
+		}
+		if err != nil {
+			return pkg.PulumiResource{}, fmt.Errorf("failed to unmarshal attrs JSON: %w", err)
+		}
 	}
 
 	sensitiveCtyPaths := make([]cty.Path, len(instance.Current.AttrSensitivePaths))
@@ -80,10 +98,16 @@ func TranslateResourceInstance(
 	if err != nil {
 		return pkg.PulumiResource{}, fmt.Errorf("failed to extract inputs from outputs: %w", err)
 	}
+	inputs = pkg.DropOversizedInputs(inputs, res.Addr.Instance(key).String())
+
+	importID, err := pkg.ResourceImportID(resourceInfo, props, res.Addr.Instance(key).String())
+	if err != nil {
+		return pkg.PulumiResource{}, err
+	}
 
 	return pkg.PulumiResource{
 		PulumiResourceID: pkg.PulumiResourceID{
-			ID:   props["id"].StringValue(),
+			ID:   importID,
 			Name: pkg.PulumiNameFromTerraformAddress(res.Addr.Instance(key).String(), resourceType),
 			Type: string(pulumiTypeToken),
 		},
@@ -92,6 +116,39 @@ func TranslateResourceInstance(
 	}, nil
 }
 
+// filterUnknownAttributes removes top-level attributes not present in the schema's implied object
+// type from a resource instance's attribute JSON. Returns the filtered JSON and the sorted names
+// of the dropped attributes; the input is returned unchanged when every attribute is known.
+func filterUnknownAttributes(attrsJSON []byte, ctyType cty.Type) ([]byte, []string, error) {
+	if !ctyType.IsObjectType() {
+		return attrsJSON, nil, nil
+	}
+
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(attrsJSON, &attrs); err != nil {
+		return nil, nil, err
+	}
+
+	known := ctyType.AttributeTypes()
+	var dropped []string
+	for name := range attrs {
+		if _, ok := known[name]; !ok {
+			dropped = append(dropped, name)
+			delete(attrs, name)
+		}
+	}
+	if len(dropped) == 0 {
+		return attrsJSON, nil, nil
+	}
+	sort.Strings(dropped)
+
+	filtered, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return filtered, dropped, nil
+}
+
 // TranslateResult contains the results of translating a Terraform statefile.
 type TranslateResult struct {
 	// Resources contains successfully translated resources.
@@ -122,6 +179,24 @@ func TranslateStateFile(
 	ctx context.Context,
 	sf *states.State,
 	providers map[providermap.TerraformProviderName]*pkg.ProviderWithMetadata,
+) (*TranslateResult, error) {
+	return TranslateStateFileWithOptions(ctx, sf, providers, TranslateStateFileOptions{})
+}
+
+// TranslateStateFileOptions contains optional settings for TranslateStateFile.
+type TranslateStateFileOptions struct {
+	// PreserveForEachKeys names keyed instances with their for_each key or count index appended
+	// with a hyphen (web-a, web-0) instead of Terraform's index syntax (web["a"], web[0]), so
+	// logical names map cleanly onto a Pulumi program iterating over the same map.
+	PreserveForEachKeys bool
+}
+
+// TranslateStateFileWithOptions is TranslateStateFile with explicit options.
+func TranslateStateFileWithOptions(
+	ctx context.Context,
+	sf *states.State,
+	providers map[providermap.TerraformProviderName]*pkg.ProviderWithMetadata,
+	opts TranslateStateFileOptions,
 ) (*TranslateResult, error) {
 	if sf == nil {
 		return &TranslateResult{}, nil
@@ -135,7 +210,7 @@ func TranslateStateFile(
 		}
 	}
 
-	upgrader := NewStateUpgrader(tfVersions)
+	upgrader := NewSharedStateUpgrader(tfVersions)
 	defer upgrader.Close()
 
 	result := &TranslateResult{}
@@ -187,7 +262,12 @@ func TranslateStateFile(
 				translated, err := TranslateResourceInstance(res, key, provider.Provider)
 				if err != nil {
 					upgradedInstance, upgradeErr := upgrader.UpgradeInstance(ctx, res, key)
-					if upgradeErr == nil && upgradedInstance != nil {
+					switch {
+					case upgradeErr != nil:
+						// Surface the failed upgrade attempt alongside the translation error so
+						// the skip reason explains why the fallback did not help.
+						err = fmt.Errorf("%w (state upgrade also failed: %v)", err, upgradeErr)
+					case upgradedInstance != nil:
 						instance.Current = upgradedInstance
 						translated, err = TranslateResourceInstance(res, key, provider.Provider)
 					}
@@ -203,6 +283,12 @@ func TranslateStateFile(
 					continue
 				}
 
+				if opts.PreserveForEachKeys {
+					if keyed := pkg.PulumiNameWithInstanceKey(address, resourceType); keyed != "" {
+						translated.Name = keyed
+					}
+				}
+
 				result.Resources = append(result.Resources, translated)
 			}
 		}
@@ -27,7 +27,10 @@ import (
 	"github.com/pulumi/opentofu/states/statefile"
 	tfmigrate "github.com/pulumi/pulumi-tool-terraform-migrate/pkg"
 	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/providermap"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
 )
 
 // discoverTestCases finds all terraform.tfstate files in testdata subdirectories.
@@ -78,3 +81,64 @@ func TestTranslateResource(t *testing.T) {
 		})
 	}
 }
+
+func TestTranslateStateFile_upgrades_random_schema(t *testing.T) {
+	t.Parallel()
+
+	// The number→numeric rename in the random provider is the canonical schema-upgrade case: the
+	// v1 state lacks "numeric", so strict translation fails until the provider's
+	// UpgradeResourceState RPC fills it in via the upgrade-on-error fallback.
+	tfStateBytes, err := os.ReadFile("testdata/random_string_v1/terraform.tfstate")
+	require.NoError(t, err)
+
+	sf, err := statefile.Read(bytes.NewReader(tfStateBytes), encryption.StateEncryptionDisabled())
+	require.NoError(t, err)
+
+	providers, err := tfmigrate.PulumiProvidersForTerraformProviders([]providermap.TerraformProviderName{
+		"registry.terraform.io/hashicorp/random",
+	}, nil)
+	require.NoError(t, err, "failed to get provider mappings")
+
+	result, err := TranslateStateFile(t.Context(), sf.State, providers)
+	require.NoError(t, err, "failed to translate statefile")
+	require.Empty(t, result.Skipped, "the schema upgrade should prevent skips: %v", result.Skipped)
+	require.Len(t, result.Resources, 1)
+
+	translated := result.Resources[0]
+	require.Equal(t, "random:index/randomString:RandomString", translated.Type)
+	numeric, ok := translated.Outputs[resource.PropertyKey("numeric")]
+	require.True(t, ok, "the upgraded state should carry the numeric attribute")
+	require.True(t, numeric.BoolValue())
+}
+
+func Test_filterUnknownAttributes(t *testing.T) {
+	t.Parallel()
+
+	objType := cty.Object(map[string]cty.Type{
+		"id":       cty.String,
+		"triggers": cty.Map(cty.String),
+	})
+
+	t.Run("drops attributes unknown to the schema", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := []byte(`{"id":"abc","triggers":null,"connection_info":{"type":"ssh"},"provisioner_meta":"x"}`)
+		filtered, dropped, err := filterUnknownAttributes(attrs, objType)
+		require.NoError(t, err)
+		require.Equal(t, []string{"connection_info", "provisioner_meta"}, dropped)
+
+		value, err := ctyjson.Unmarshal(filtered, objType)
+		require.NoError(t, err)
+		require.Equal(t, "abc", value.GetAttr("id").AsString())
+	})
+
+	t.Run("returns input unchanged when every attribute is known", func(t *testing.T) {
+		t.Parallel()
+
+		attrs := []byte(`{"id":"abc","triggers":null}`)
+		filtered, dropped, err := filterUnknownAttributes(attrs, objType)
+		require.NoError(t, err)
+		require.Empty(t, dropped)
+		require.Equal(t, attrs, filtered)
+	})
+}
@@ -16,10 +16,7 @@ package statefile
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log/slog"
-	"sync"
 
 	"github.com/pulumi/opentofu/addrs"
 	"github.com/pulumi/opentofu/states"
@@ -28,61 +25,60 @@ import (
 )
 
 // StateUpgrader manages TF provider processes for state upgrades.
-// Providers are loaded lazily on first use and cached for reuse.
+// Providers are loaded lazily on first use through a tfprovider.Manager and cached for reuse.
 // Call Close() when done to clean up provider processes.
 type StateUpgrader struct {
-	mu        sync.Mutex
-	versions  map[string]string // tfProviderName -> version
-	providers map[string]tfprovider.Provider
+	versions map[string]string // tfProviderName -> version
+	manager  *tfprovider.Manager
+
+	// ownsManager is true when the upgrader created its own manager and is responsible for
+	// closing it. Upgraders sharing the process-wide manager leave its providers running so
+	// other phases of the migration run can reuse them.
+	ownsManager bool
 }
 
 // NewStateUpgrader creates a new upgrader with the specified provider versions.
 // The versions map keys should be TF provider addresses (e.g., "registry.terraform.io/hashicorp/aws"
-// or just "hashicorp/aws") and values should be version constraints (e.g., "5.0.0").
+// or just "hashicorp/aws") and values should be version constraints (e.g., "5.0.0"), typically the
+// upstream versions already discovered by the provider mapping step.
 // Providers are loaded lazily on first use.
 func NewStateUpgrader(versions map[string]string) *StateUpgrader {
+	return NewStateUpgraderWithOptions(versions, tfprovider.LoadProviderOptions{})
+}
+
+// NewStateUpgraderWithOptions is NewStateUpgrader with explicit provider load options, for
+// callers that need to redirect the provider cache or registry for a single run.
+func NewStateUpgraderWithOptions(versions map[string]string, opts tfprovider.LoadProviderOptions) *StateUpgrader {
+	return &StateUpgrader{
+		versions:    versions,
+		manager:     tfprovider.NewManagerWithOptions(opts),
+		ownsManager: true,
+	}
+}
+
+// NewSharedStateUpgrader creates an upgrader backed by the process-wide tfprovider.SharedManager,
+// so provider downloads and processes are shared with other consumers in the same run.
+func NewSharedStateUpgrader(versions map[string]string) *StateUpgrader {
 	return &StateUpgrader{
-		versions:  versions,
-		providers: make(map[string]tfprovider.Provider),
+		versions: versions,
+		manager:  tfprovider.SharedManager(),
 	}
 }
 
 // Close shuts down all cached providers. Should be called when done with state upgrades.
+// For upgraders backed by the shared manager this is a no-op; the shared providers stay
+// active for the rest of the process.
 func (p *StateUpgrader) Close() error {
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
-	var errs []error
-	for addr, prov := range p.providers {
-		if err := prov.Close(context.Background()); err != nil {
-			slog.Warn("Failed to close provider", "addr", addr, "error", err)
-			errs = append(errs, fmt.Errorf("close provider %s: %w", addr, err))
-		}
+	if !p.ownsManager {
+		return nil
 	}
-	p.providers = make(map[string]tfprovider.Provider)
-	return errors.Join(errs...)
+	return p.manager.Close()
 }
 
 // getProvider returns a cached provider or loads a new one.
 // The version is looked up from the versions map provided at construction time.
 func (p *StateUpgrader) getProvider(ctx context.Context, providerAddr string) (tfprovider.Provider, error) {
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
-	if prov, ok := p.providers[providerAddr]; ok {
-		return prov, nil
-	}
-
-	// Look up version from the versions map
-	version := p.versions[providerAddr]
-
-	prov, err := tfprovider.LoadProvider(ctx, providerAddr, version)
-	if err != nil {
-		return nil, err
-	}
-
-	p.providers[providerAddr] = prov
-	return prov, nil
+	return p.manager.GetProvider(ctx, providerAddr, p.versions[providerAddr])
 }
 
 // UpgradeInstance upgrades a resource instance using the TF provider's UpgradeResourceState RPC.
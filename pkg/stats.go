@@ -0,0 +1,68 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// statsEnabled is the process-wide setting controlled by the --stats flag.
+var statsEnabled bool
+
+// SetStatsEnabled controls whether phase timings are collected and printed. When enabled, the
+// wall-clock time spent in each translation phase (state load, provider install, value
+// conversion, file write, ...) is printed to stderr at the end of the run, which helps identify
+// the dominating phase on a given workload.
+func SetStatsEnabled(v bool) {
+	statsEnabled = v
+}
+
+// phaseStat is one timed phase of a run.
+type phaseStat struct {
+	name     string
+	duration time.Duration
+}
+
+// phaseStats collects timings in the order the phases ran.
+var phaseStats []phaseStat
+
+// TimePhase runs f, recording its wall-clock duration under the given phase name when stats are
+// enabled. It returns f's error unchanged.
+func TimePhase(name string, f func() error) error {
+	if !statsEnabled {
+		return f()
+	}
+	start := time.Now()
+	err := f()
+	phaseStats = append(phaseStats, phaseStat{name: name, duration: time.Since(start)})
+	return err
+}
+
+// PrintStats writes the collected phase timings to stderr. It is a no-op when stats are disabled
+// or nothing was recorded.
+func PrintStats() {
+	if !statsEnabled || len(phaseStats) == 0 {
+		return
+	}
+	var total time.Duration
+	fmt.Fprintf(os.Stderr, "Phase timings:\n")
+	for _, s := range phaseStats {
+		fmt.Fprintf(os.Stderr, "  %-25s %s\n", s.name, s.duration.Round(time.Millisecond))
+		total += s.duration
+	}
+	fmt.Fprintf(os.Stderr, "  %-25s %s\n", "total", total.Round(time.Millisecond))
+}
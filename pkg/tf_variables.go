@@ -0,0 +1,117 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// loadTerraformVariables resolves the values of the root module's input variables as far as that is
+// possible without running Terraform: variable block defaults first, then terraform.tfvars,
+// terraform.tfvars.json and *.auto.tfvars(.json) files in Terraform's own precedence order.
+// Variables with no default and no tfvars entry are simply absent from the result.
+func loadTerraformVariables(tfDir string) (map[string]cty.Value, error) {
+	entries, err := os.ReadDir(tfDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Terraform directory: %w", err)
+	}
+
+	parser := hclparse.NewParser()
+	variables := map[string]cty.Value{}
+
+	// Variable defaults from the .tf configuration files.
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".tf") {
+			continue
+		}
+		file, diags := parser.ParseHCLFile(filepath.Join(tfDir, entry.Name()))
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("failed to parse %s: %w", entry.Name(), diags)
+		}
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type != "variable" || len(block.Labels) != 1 {
+				continue
+			}
+			attr, ok := block.Body.Attributes["default"]
+			if !ok {
+				continue
+			}
+			value, diags := attr.Expr.Value(nil)
+			if diags.HasErrors() {
+				continue
+			}
+			variables[block.Labels[0]] = value
+		}
+	}
+
+	// tfvars files override defaults; later files in the precedence order override earlier ones.
+	var tfvarsFiles []string
+	for _, name := range []string{"terraform.tfvars", "terraform.tfvars.json"} {
+		if _, err := os.Stat(filepath.Join(tfDir, name)); err == nil {
+			tfvarsFiles = append(tfvarsFiles, name)
+		}
+	}
+	var autoFiles []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(entry.Name(), ".auto.tfvars") || strings.HasSuffix(entry.Name(), ".auto.tfvars.json") {
+			autoFiles = append(autoFiles, entry.Name())
+		}
+	}
+	sort.Strings(autoFiles)
+	tfvarsFiles = append(tfvarsFiles, autoFiles...)
+
+	for _, name := range tfvarsFiles {
+		path := filepath.Join(tfDir, name)
+		var file *hcl.File
+		var diags hcl.Diagnostics
+		if strings.HasSuffix(name, ".json") {
+			file, diags = parser.ParseJSONFile(path)
+		} else {
+			file, diags = parser.ParseHCLFile(path)
+		}
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("failed to parse %s: %w", name, diags)
+		}
+		attrs, diags := file.Body.JustAttributes()
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("failed to read variables from %s: %w", name, diags)
+		}
+		for varName, attr := range attrs {
+			value, diags := attr.Expr.Value(nil)
+			if diags.HasErrors() {
+				continue
+			}
+			variables[varName] = value
+		}
+	}
+
+	return variables, nil
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/apparentlymart/go-versions/versions"
 	plugin "github.com/hashicorp/go-plugin"
+	svchost "github.com/opentofu/svchost"
 	disco "github.com/opentofu/svchost/disco"
 	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/vendored/opentofu/addrs"
 	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/vendored/opentofu/getproviders"
@@ -50,6 +51,12 @@ import (
 // It defaults to `$PULUMI_HOME/dynamic_tf_plugins`.
 const envPluginCache = "PULUMI_DYNAMIC_TF_PLUGIN_CACHE_DIR"
 
+// envRegistryHost allows users in air-gapped environments to redirect provider downloads to an
+// internal registry mirror (e.g. "registry.example.com"). It replaces the default
+// registry.terraform.io host for provider addresses that do not name an explicit host. An explicit
+// host passed via LoadProviderOptions.RegistryHost takes precedence over the environment variable.
+const envRegistryHost = "PULUMI_TF_MIGRATE_REGISTRY"
+
 // Provider represents a running Terraform provider with access to its gRPC interface.
 // You must call Close on any Provider that has been created.
 type Provider interface {
@@ -59,21 +66,76 @@ type Provider interface {
 	Version() string
 }
 
+// LoadProviderOptions contains optional settings for LoadProvider.
+type LoadProviderOptions struct {
+	// RegistryHost overrides the registry host used for provider addresses that do not name an
+	// explicit host (e.g. "registry.example.com" for an internal mirror). When empty, the
+	// PULUMI_TF_MIGRATE_REGISTRY environment variable is consulted; the explicit option always
+	// takes precedence over the environment variable.
+	RegistryHost string
+
+	// CacheDir overrides where downloaded TF providers are cached for this load. When empty,
+	// the process-wide override (SetCacheDirOverride), the PULUMI_DYNAMIC_TF_PLUGIN_CACHE_DIR
+	// environment variable, and finally $PULUMI_HOME/dynamic_tf_plugins are consulted in turn.
+	CacheDir string
+}
+
 // LoadProvider loads a Terraform provider by its registry address and version.
 // The providerAddr is the provider source address (e.g., "hashicorp/aws" or "registry.terraform.io/hashicorp/aws").
 // The version must be an exact version (e.g., "5.0.0").
 func LoadProvider(ctx context.Context, providerAddr, version string) (Provider, error) {
+	return LoadProviderWithOptions(ctx, providerAddr, version, LoadProviderOptions{})
+}
+
+// LoadProviderWithOptions is LoadProvider with explicit options, for callers that need to
+// redirect registry discovery to an internal mirror.
+func LoadProviderWithOptions(
+	ctx context.Context, providerAddr, version string, opts LoadProviderOptions,
+) (Provider, error) {
 	addr, diags := addrs.ParseProviderSourceString(providerAddr)
 	if diags.HasErrors() {
 		return nil, fmt.Errorf("invalid provider name %q: %s", providerAddr, diags.Err())
 	}
 
+	addr, err := applyRegistryHostOverride(addr, opts.RegistryHost)
+	if err != nil {
+		return nil, err
+	}
+
 	v, err := getproviders.ParseVersion(version)
 	if err != nil {
 		return nil, fmt.Errorf("invalid version %q: %w", version, err)
 	}
 
-	return getProviderServer(ctx, addr, v, disco.New())
+	return getProviderServer(ctx, addr, v, disco.New(), opts.CacheDir)
+}
+
+// cacheDirOverride is a process-wide cache location override, set from the --provider-cache-dir
+// CLI flag. It takes precedence over the environment variable but not over an explicit
+// LoadProviderOptions.CacheDir.
+var cacheDirOverride string
+
+// SetCacheDirOverride overrides the TF provider cache location for the rest of the process, so CI
+// jobs can point a single run at a mounted cache volume without setting environment variables.
+func SetCacheDirOverride(dir string) { cacheDirOverride = dir }
+
+// applyRegistryHostOverride rewrites the provider address to use the configured registry mirror
+// host, if any. Only addresses pointing at the default public registry are rewritten; addresses
+// that already name an explicit alternative host are left alone.
+func applyRegistryHostOverride(addr addrs.Provider, explicitHost string) (addrs.Provider, error) {
+	host := explicitHost
+	if host == "" {
+		host = os.Getenv(envRegistryHost)
+	}
+	if host == "" || addr.Hostname != addrs.DefaultProviderRegistryHost {
+		return addr, nil
+	}
+
+	hostname, err := svchost.ForComparison(host)
+	if err != nil {
+		return addrs.Provider{}, fmt.Errorf("invalid registry host %q: %w", host, err)
+	}
+	return addrs.NewProvider(hostname, addr.Namespace, addr.Type), nil
 }
 
 // provider wraps a providers.Interface with metadata.
@@ -88,7 +150,13 @@ func (p *provider) Name() string                  { return p.name }
 func (p *provider) Version() string               { return p.version }
 func (p *provider) Close(_ context.Context) error { return p.close() }
 
-func getPluginCache() (string, error) {
+func getPluginCache(explicitDir string) (string, error) {
+	if explicitDir != "" {
+		return explicitDir, nil
+	}
+	if cacheDirOverride != "" {
+		return cacheDirOverride, nil
+	}
 	if dir := os.Getenv(envPluginCache); dir != "" {
 		return dir, nil
 	}
@@ -97,9 +165,9 @@ func getPluginCache() (string, error) {
 
 func getProviderServer(
 	ctx context.Context, addr addrs.Provider, version versions.Version,
-	registryDisco *disco.Disco,
+	registryDisco *disco.Disco, explicitCacheDir string,
 ) (Provider, error) {
-	cacheDir, err := getPluginCache()
+	cacheDir, err := getPluginCache(explicitCacheDir)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,125 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tfprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+)
+
+// Manager caches loaded Terraform providers by address and version so that different phases of a
+// migration run (mapping discovery, state upgrades) share a single download and provider process
+// per logical provider instead of each fetching and starting their own copy.
+type Manager struct {
+	mu        sync.Mutex
+	providers map[string]Provider // keyed by providerAddr@version
+	order     []string            // cache keys, least recently used first
+	opts      LoadProviderOptions
+}
+
+// NewManager creates an empty provider manager. Call Close when done to shut down any providers
+// the manager has loaded.
+func NewManager() *Manager {
+	return NewManagerWithOptions(LoadProviderOptions{})
+}
+
+// NewManagerWithOptions is NewManager with explicit load options applied to every provider the
+// manager loads, e.g. a per-run cache directory.
+func NewManagerWithOptions(opts LoadProviderOptions) *Manager {
+	return &Manager{providers: make(map[string]Provider), opts: opts}
+}
+
+var sharedManager = NewManager()
+
+// SharedManager returns the process-wide provider manager. Providers loaded through it stay
+// cached for the lifetime of the process, so consecutive stacks in a single migration run reuse
+// the same provider processes.
+func SharedManager() *Manager {
+	return sharedManager
+}
+
+// GetProvider returns a cached provider for the given address and version, loading it on first
+// use. See LoadProvider for the accepted address and version formats.
+func (m *Manager) GetProvider(ctx context.Context, providerAddr, version string) (Provider, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := providerAddr + "@" + version
+	if prov, ok := m.providers[key]; ok {
+		m.markUsed(key)
+		return prov, nil
+	}
+
+	prov, err := LoadProviderWithOptions(ctx, providerAddr, version, m.opts)
+	if err != nil {
+		return nil, err
+	}
+
+	m.cacheProvider(ctx, key, prov)
+	return prov, nil
+}
+
+// markUsed moves a cache key to the most-recently-used end of the eviction order. Callers must
+// hold m.mu.
+func (m *Manager) markUsed(key string) {
+	for i, k := range m.order {
+		if k == key {
+			m.order = append(append(m.order[:i:i], m.order[i+1:]...), key)
+			return
+		}
+	}
+}
+
+// cacheProvider records a freshly loaded provider and, when --threads caps the number of provider
+// processes, closes least-recently-used providers until the cache fits the limit again. Evicted
+// providers reload on demand the next time they are requested. Callers must hold m.mu.
+func (m *Manager) cacheProvider(ctx context.Context, key string, prov Provider) {
+	m.providers[key] = prov
+	m.order = append(m.order, key)
+
+	limit := maxProviderProcesses
+	if limit <= 0 {
+		return
+	}
+	for len(m.order) > limit {
+		victim := m.order[0]
+		m.order = m.order[1:]
+		evicted := m.providers[victim]
+		delete(m.providers, victim)
+		if err := evicted.Close(ctx); err != nil {
+			slog.Warn("Failed to close evicted provider", "provider", victim, "error", err)
+		}
+	}
+}
+
+// Close shuts down all providers loaded by this manager.
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var errs []error
+	for key, prov := range m.providers {
+		if err := prov.Close(context.Background()); err != nil {
+			slog.Warn("Failed to close provider", "provider", key, "error", err)
+			errs = append(errs, fmt.Errorf("close provider %s: %w", key, err))
+		}
+	}
+	m.providers = make(map[string]Provider)
+	m.order = nil
+	return errors.Join(errs...)
+}
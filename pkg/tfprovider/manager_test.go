@@ -0,0 +1,70 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tfprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// closeRecordingProvider records its cache key when closed; every other provider method panics if
+// called, which is fine for exercising the manager's cache bookkeeping.
+type closeRecordingProvider struct {
+	Provider
+
+	key    string
+	closed *[]string
+}
+
+func (p *closeRecordingProvider) Close(context.Context) error {
+	*p.closed = append(*p.closed, p.key)
+	return nil
+}
+
+// Not parallel: mutates the package-level provider process limit.
+func Test_managerEvictsLeastRecentlyUsedProvider(t *testing.T) {
+	SetMaxProviderProcesses(2)
+	defer SetMaxProviderProcesses(0)
+
+	ctx := context.Background()
+	var closed []string
+	m := NewManager()
+	cache := func(key string) {
+		m.cacheProvider(ctx, key, &closeRecordingProvider{key: key, closed: &closed})
+	}
+
+	cache("registry.terraform.io/hashicorp/aws@5.0.0")
+	cache("registry.terraform.io/hashicorp/random@3.6.0")
+	require.Empty(t, closed, "caching up to the limit evicts nothing")
+
+	// A cache hit on aws makes random the least recently used, so the next load evicts random.
+	m.markUsed("registry.terraform.io/hashicorp/aws@5.0.0")
+	cache("registry.terraform.io/hashicorp/tls@4.0.0")
+	require.Equal(t, []string{"registry.terraform.io/hashicorp/random@3.6.0"}, closed)
+	require.Len(t, m.providers, 2)
+	require.NotContains(t, m.providers, "registry.terraform.io/hashicorp/random@3.6.0",
+		"evicted providers are removed from the cache so they reload on demand")
+
+	require.NoError(t, m.Close())
+	require.ElementsMatch(t, []string{
+		"registry.terraform.io/hashicorp/random@3.6.0",
+		"registry.terraform.io/hashicorp/aws@5.0.0",
+		"registry.terraform.io/hashicorp/tls@4.0.0",
+	}, closed, "Close still shuts down every remaining provider")
+	require.Empty(t, m.providers)
+	require.Empty(t, m.order)
+}
@@ -0,0 +1,76 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tfprovider
+
+import (
+	"context"
+
+	"github.com/pulumi/pulumi-terraform-bridge/v3/pkg/vendored/opentofu/providers"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// MockProviderServer wraps a running provider for schema-only workflows that never plan or apply.
+// Most providers accept an empty configuration for such use, but some validate required settings
+// during Configure (the aws region, the gcp project, the azure subscription). The mock fills those
+// settings from a caller-supplied minimal config before forwarding, so schema loading works
+// without the caller having to fake a full provider block.
+type MockProviderServer struct {
+	Provider
+
+	config map[string]cty.Value
+}
+
+// NewMockProviderServer wraps a provider with a minimal configuration. The config entries fill
+// attributes the incoming Configure call leaves null or absent; attributes the caller does set are
+// never overridden. Pass nil when the provider needs no configuration.
+func NewMockProviderServer(p Provider, config map[string]cty.Value) *MockProviderServer {
+	return &MockProviderServer{Provider: p, config: config}
+}
+
+// ConfigureProvider forwards the configure call with the minimal config merged in.
+func (m *MockProviderServer) ConfigureProvider(
+	ctx context.Context, req providers.ConfigureProviderRequest,
+) providers.ConfigureProviderResponse {
+	req.Config = m.mergeConfig(req.Config)
+	return m.Provider.ConfigureProvider(ctx, req)
+}
+
+// ValidateProviderConfig validates the same merged config that Configure will see.
+func (m *MockProviderServer) ValidateProviderConfig(
+	ctx context.Context, req providers.ValidateProviderConfigRequest,
+) providers.ValidateProviderConfigResponse {
+	req.Config = m.mergeConfig(req.Config)
+	return m.Provider.ValidateProviderConfig(ctx, req)
+}
+
+// mergeConfig fills null or absent attributes of the given config object from the minimal config.
+func (m *MockProviderServer) mergeConfig(config cty.Value) cty.Value {
+	if len(m.config) == 0 {
+		return config
+	}
+
+	merged := map[string]cty.Value{}
+	if !config.IsNull() && config.Type().IsObjectType() {
+		for name, value := range config.AsValueMap() {
+			merged[name] = value
+		}
+	}
+	for name, value := range m.config {
+		if existing, ok := merged[name]; !ok || existing.IsNull() {
+			merged[name] = value
+		}
+	}
+	return cty.ObjectVal(merged)
+}
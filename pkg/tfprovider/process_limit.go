@@ -0,0 +1,27 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tfprovider
+
+// maxProviderProcesses caps how many provider plugin processes a Manager keeps running at once.
+// Zero, the default, means no limit.
+var maxProviderProcesses int
+
+// SetMaxProviderProcesses limits the number of concurrently running provider plugin processes per
+// Manager, as requested by the --threads flag. When a load would exceed the limit, the manager
+// closes its least-recently-used provider first; closed providers reload on demand the next time
+// they are requested. Pass 0 to remove the limit.
+func SetMaxProviderProcesses(n int) {
+	maxProviderProcesses = n
+}
@@ -0,0 +1,135 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// rawState mirrors the few fields of the raw .tfstate (version 4) format needed to enumerate
+// resource addresses without loading provider schemas.
+type rawState struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey interface{} `json:"index_key"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// LoadStateAddresses lists the managed resource addresses in a Terraform or OpenTofu state. Unlike
+// [LoadTerraformState] it never runs `tofu show`, so no provider schemas are loaded and the
+// registry rewrite workaround is not needed; the state is read with `tofu state pull` (or directly
+// from a file) and parsed minimally. Use it for callers that only need to enumerate addresses,
+// such as state consistency checks. Data sources are skipped, matching the default behavior of
+// [VisitResources].
+func LoadStateAddresses(ctx context.Context, opts LoadTerraformStateOptions) ([]string, error) {
+	if opts.TFCloud != nil {
+		stateBytes, err := fetchTFCloudState(ctx, opts.TFCloud)
+		if err != nil {
+			return nil, err
+		}
+		return stateAddresses(stateBytes)
+	}
+
+	if opts.StateFilePath != "" {
+		stateBytes, err := readStateFile(opts.StateFilePath)
+		if err != nil {
+			return nil, err
+		}
+		return stateAddresses(stateBytes)
+	}
+
+	tofu, err := tofuNew(opts.ProjectDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := tofu.Init(ctx); err != nil {
+		return nil, fmt.Errorf("tofu init failed: %w", classifyTofuError(err))
+	}
+	if opts.Workspace != "" {
+		if err := tofu.WorkspaceSelect(ctx, opts.Workspace); err != nil {
+			return nil, fmt.Errorf("tofu workspace select failed: %w", err)
+		}
+	}
+	stateData, err := tofu.StatePull(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("tofu state pull failed: %w", err)
+	}
+	return stateAddresses([]byte(stateData))
+}
+
+// stateAddresses extracts managed resource addresses from state JSON, accepting both the raw
+// .tfstate layout and the `tofu show -json` layout.
+func stateAddresses(data []byte) ([]string, error) {
+	var raw rawState
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse state: %w", err)
+	}
+
+	// Raw states carry a numeric top-level version; show output has format_version instead.
+	if raw.Version == 0 {
+		var st tfjson.State
+		if err := json.Unmarshal(data, &st); err != nil {
+			return nil, fmt.Errorf("failed to parse state: %w", err)
+		}
+		var addrs []string
+		err := VisitResources(&st, func(res *tfjson.StateResource) error {
+			addrs = append(addrs, res.Address)
+			return nil
+		}, nil) // Use default options (skips data sources)
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(addrs)
+		return addrs, nil
+	}
+
+	var addrs []string
+	for _, res := range raw.Resources {
+		if res.Mode != "managed" {
+			continue
+		}
+		base := res.Type + "." + res.Name
+		if res.Module != "" {
+			base = res.Module + "." + base
+		}
+		if len(res.Instances) == 0 {
+			addrs = append(addrs, base)
+			continue
+		}
+		for _, inst := range res.Instances {
+			switch key := inst.IndexKey.(type) {
+			case nil:
+				addrs = append(addrs, base)
+			case string:
+				addrs = append(addrs, fmt.Sprintf("%s[%q]", base, key))
+			default:
+				addrs = append(addrs, fmt.Sprintf("%s[%v]", base, key))
+			}
+		}
+	}
+	sort.Strings(addrs)
+	return addrs, nil
+}
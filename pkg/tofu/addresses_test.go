@@ -0,0 +1,98 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stateAddresses_raw(t *testing.T) {
+	t.Parallel()
+
+	raw := `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_instance",
+      "name": "web",
+      "instances": [{"index_key": "a"}, {"index_key": "b"}]
+    },
+    {
+      "module": "module.app",
+      "mode": "managed",
+      "type": "aws_s3_bucket",
+      "name": "logs",
+      "instances": [{"index_key": 0}, {"index_key": 1}]
+    },
+    {
+      "mode": "managed",
+      "type": "random_string",
+      "name": "suffix",
+      "instances": [{}]
+    },
+    {
+      "mode": "data",
+      "type": "aws_ami",
+      "name": "latest",
+      "instances": [{}]
+    }
+  ]
+}`
+
+	addrs, err := stateAddresses([]byte(raw))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`aws_instance.web["a"]`,
+		`aws_instance.web["b"]`,
+		"module.app.aws_s3_bucket.logs[0]",
+		"module.app.aws_s3_bucket.logs[1]",
+		"random_string.suffix",
+	}, addrs)
+}
+
+func Test_stateAddresses_show_format(t *testing.T) {
+	t.Parallel()
+
+	show := `{
+  "format_version": "1.0",
+  "values": {
+    "root_module": {
+      "resources": [
+        {
+          "address": "aws_instance.web",
+          "mode": "managed",
+          "type": "aws_instance",
+          "name": "web",
+          "provider_name": "registry.terraform.io/hashicorp/aws"
+        }
+      ]
+    }
+  }
+}`
+
+	addrs, err := stateAddresses([]byte(show))
+	require.NoError(t, err)
+	require.Equal(t, []string{"aws_instance.web"}, addrs)
+}
+
+func Test_stateAddresses_invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := stateAddresses([]byte("not json"))
+	require.ErrorContains(t, err, "failed to parse state")
+}
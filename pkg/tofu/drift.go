@@ -0,0 +1,86 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// DriftReport summarizes how far a Terraform state has drifted from the live infrastructure.
+type DriftReport struct {
+	// ChangedAddresses lists the resources that would change on `tofu apply`, in plan order.
+	ChangedAddresses []string
+}
+
+// HasDrift reports whether any resource would change on apply.
+func (r *DriftReport) HasDrift() bool {
+	return len(r.ChangedAddresses) > 0
+}
+
+// DetectDrift runs a read-only `tofu plan` over a Terraform project and reports the resources
+// whose recorded state no longer matches the live infrastructure. Only plan is run, never apply;
+// the plan file is written to a temp directory and removed afterwards. The plan's refresh phase
+// may require read-only cloud credentials, like the state extraction commands.
+func DetectDrift(ctx context.Context, projectDir string) (*DriftReport, error) {
+	tofu, err := tofuNew(projectDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tofu.Init(ctx); err != nil {
+		return nil, fmt.Errorf("tofu init failed: %w", classifyTofuError(err))
+	}
+
+	tmpDir, err := os.MkdirTemp("", "tofu-drift-plan-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+	planFile := filepath.Join(tmpDir, "plan.tfplan")
+
+	hasChanges, err := tofu.Plan(ctx, tfexec.Out(planFile))
+	if err != nil {
+		return nil, fmt.Errorf("tofu plan failed: %w", classifyTofuError(err))
+	}
+	if !hasChanges {
+		return &DriftReport{}, nil
+	}
+
+	plan, err := tofu.ShowPlanFile(ctx, planFile)
+	if err != nil {
+		return nil, fmt.Errorf("tofu show on plan file failed: %w", classifyTofuError(err))
+	}
+
+	return &DriftReport{ChangedAddresses: driftedResources(plan)}, nil
+}
+
+// driftedResources extracts the addresses of resources a plan would change, skipping no-op and
+// read-only entries.
+func driftedResources(plan *tfjson.Plan) []string {
+	var changed []string
+	for _, change := range plan.ResourceChanges {
+		if change.Change == nil || change.Change.Actions.NoOp() || change.Change.Actions.Read() {
+			continue
+		}
+		changed = append(changed, change.Address)
+	}
+	return changed
+}
@@ -0,0 +1,70 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sentinel errors for common tofu failure modes. LoadTerraformState and related functions wrap
+// underlying tofu errors with these so callers can branch with errors.Is instead of matching
+// message strings, and print targeted remediation.
+var (
+	// ErrTofuNotFound indicates the tofu binary is not in PATH.
+	ErrTofuNotFound = errors.New("tofu binary not found in PATH")
+
+	// ErrBackendAuth indicates tofu failed to authenticate to a state backend such as S3.
+	ErrBackendAuth = errors.New("failed to authenticate to the state backend")
+
+	// ErrRegistrySchema indicates OpenTofu could not load provider schemas, typically because
+	// the state references providers from the Terraform registry that OpenTofu cannot resolve.
+	// See https://github.com/pulumi/pulumi-service/issues/34864
+	ErrRegistrySchema = errors.New("failed to load provider schemas")
+
+	// ErrModuleSourceChanged indicates tofu init detected a module source change, typically
+	// caused by a .terraform.lock.hcl or .terraform directory produced by a different tool.
+	ErrModuleSourceChanged = errors.New("module source has changed")
+)
+
+// classifyTofuError wraps err with a matching sentinel error when the underlying tofu failure is
+// recognized, so callers can branch with errors.Is. Unrecognized errors are returned unchanged.
+func classifyTofuError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+	switch {
+	case isRegistrySchemaMessage(msg):
+		return fmt.Errorf("%w: %w", ErrRegistrySchema, err)
+	case strings.Contains(msg, "Module source has changed"):
+		return fmt.Errorf("%w: %w", ErrModuleSourceChanged, err)
+	case strings.Contains(msg, "No valid credential sources"),
+		strings.Contains(msg, "error configuring S3 Backend"),
+		strings.Contains(msg, "Error refreshing state: AccessDenied"),
+		strings.Contains(msg, "failed to refresh cached credentials"):
+		return fmt.Errorf("%w: %w", ErrBackendAuth, err)
+	}
+	return err
+}
+
+// isRegistrySchemaMessage matches the OpenTofu error produced when provider schemas cannot be
+// loaded for a Terraform-generated state.
+func isRegistrySchemaMessage(msg string) bool {
+	return strings.Contains(msg, "Failed to load plugin schemas") &&
+		strings.Contains(msg, "while loading schemas for plugin components")
+}
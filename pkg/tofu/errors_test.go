@@ -0,0 +1,89 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_classifyTofuError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		message  string
+		sentinel error
+	}{
+		{
+			name: "registry schema error",
+			message: "Failed to load plugin schemas. Error: error loading state: " +
+				"while loading schemas for plugin components: failed to instantiate provider",
+			sentinel: ErrRegistrySchema,
+		},
+		{
+			name:     "module source changed",
+			message:  "Error: Module source has changed",
+			sentinel: ErrModuleSourceChanged,
+		},
+		{
+			name:     "s3 backend auth error",
+			message:  "error configuring S3 Backend: no valid credential sources for S3 Backend found",
+			sentinel: ErrBackendAuth,
+		},
+		{
+			name:     "credential refresh error",
+			message:  "failed to refresh cached credentials, no EC2 IMDS role found",
+			sentinel: ErrBackendAuth,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			classified := classifyTofuError(errors.New(tc.message))
+			assert.ErrorIs(t, classified, tc.sentinel)
+
+			// Wrapping preserves the original message for display.
+			assert.Contains(t, classified.Error(), tc.message)
+		})
+	}
+
+	t.Run("unrecognized errors pass through unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		err := errors.New("something else entirely")
+		assert.Equal(t, err, classifyTofuError(err))
+	})
+
+	t.Run("nil passes through", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, classifyTofuError(nil))
+	})
+
+	t.Run("sentinel survives further wrapping", func(t *testing.T) {
+		t.Parallel()
+
+		classified := classifyTofuError(errors.New("Error: Module source has changed"))
+		wrapped := fmt.Errorf("tofu init failed: %w", classified)
+		assert.ErrorIs(t, wrapped, ErrModuleSourceChanged)
+	})
+}
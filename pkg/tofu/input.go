@@ -0,0 +1,61 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// StateInputOptions builds LoadTerraformStateOptions for a path that may be either a state file
+// (.tfstate, .json, optionally gzip-compressed) or a Terraform project directory, so commands can
+// accept a single input flag for both. Directories are checked for Terraform configuration or
+// state up front, producing a clear error instead of a confusing tofu failure later.
+func StateInputOptions(path string) (LoadTerraformStateOptions, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return LoadTerraformStateOptions{}, fmt.Errorf("failed to read input path: %w", err)
+	}
+
+	if !info.IsDir() {
+		return LoadTerraformStateOptions{StateFilePath: path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return LoadTerraformStateOptions{}, fmt.Errorf("failed to read input directory: %w", err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			if name == ".terraform" {
+				return LoadTerraformStateOptions{ProjectDir: path}, nil
+			}
+			continue
+		}
+		switch {
+		case strings.HasSuffix(name, ".tf"),
+			strings.HasSuffix(name, ".tf.json"),
+			strings.HasSuffix(name, ".tfstate"):
+			return LoadTerraformStateOptions{ProjectDir: path}, nil
+		}
+	}
+	return LoadTerraformStateOptions{}, fmt.Errorf(
+		"%s contains no Terraform configuration or state: expected .tf files, a terraform.tfstate "+
+			"file or an initialized backend (.terraform); pass a state file directly to translate "+
+			"without a project", filepath.Clean(path))
+}
@@ -0,0 +1,56 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StateInputOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("state file", func(t *testing.T) {
+		t.Parallel()
+		opts, err := StateInputOptions("testdata/tofu-project/terraform.tfstate")
+		require.NoError(t, err)
+		assert.Equal(t, LoadTerraformStateOptions{StateFilePath: "testdata/tofu-project/terraform.tfstate"}, opts)
+	})
+
+	t.Run("project directory", func(t *testing.T) {
+		t.Parallel()
+		opts, err := StateInputOptions("testdata/tofu-project")
+		require.NoError(t, err)
+		assert.Equal(t, LoadTerraformStateOptions{ProjectDir: "testdata/tofu-project"}, opts)
+	})
+
+	t.Run("directory without configuration or state", func(t *testing.T) {
+		t.Parallel()
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("hi"), 0o600))
+		_, err := StateInputOptions(dir)
+		require.ErrorContains(t, err, "contains no Terraform configuration or state")
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		t.Parallel()
+		_, err := StateInputOptions(filepath.Join(t.TempDir(), "nope"))
+		require.ErrorContains(t, err, "failed to read input path")
+	})
+}
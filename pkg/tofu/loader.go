@@ -15,10 +15,13 @@
 package tofu
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"os/exec"
@@ -37,6 +40,9 @@ type LoadTerraformStateOptions struct {
 	// To facilitate testing, if this file path ends with .json it is simply read directly and is assumed to be in
 	// the format emitted by `tofu show -json`.
 	//
+	// Gzip-compressed files (detected by the gzip magic bytes, commonly named with a .gz suffix)
+	// are transparently decompressed first; state backups are sometimes stored compressed.
+	//
 	// Only one of [ProjectDir], [StateFilePath] should be given.
 	StateFilePath string
 
@@ -49,6 +55,19 @@ type LoadTerraformStateOptions struct {
 	//
 	// Requires [ProjectDir] to be set.
 	Workspace string
+
+	// TFCloud, if set, pulls the latest state version of a Terraform Cloud / HCP Terraform
+	// workspace through its API instead of reading local files or running a backend.
+	//
+	// Not compatible with [ProjectDir], [StateFilePath] or [Workspace].
+	TFCloud *TFCloudOptions
+
+	// InitUpgrade enables retrying `tofu init` with `-upgrade` when init fails with the
+	// "Module source has changed" error. That error is typically caused by a
+	// `.terraform.lock.hcl` pinning the terraform.io registry while tofu wants opentofu.org.
+	// The retry happens within the isolated temp area; the user's lock file is restored
+	// afterwards as usual. Off by default so re-resolution of providers is never a surprise.
+	InitUpgrade bool
 }
 
 // TofuVersionOutput represents the output of `tofu version -json`
@@ -82,17 +101,44 @@ type TofuVersionOutput struct {
 //
 // See also: https://github.com/pulumi/pulumi-service/issues/34864
 func LoadTerraformState(ctx context.Context, opts LoadTerraformStateOptions) (finalState *tfjson.State, finalError error) {
+	if opts.TFCloud != nil {
+		contract.Assertf(opts.StateFilePath == "" && opts.ProjectDir == "" && opts.Workspace == "",
+			"TFCloud is not compatible with StateFilePath, ProjectDir or Workspace")
+		stateBytes, err := fetchTFCloudState(ctx, opts.TFCloud)
+		if err != nil {
+			return nil, err
+		}
+		// The API hands back a raw .tfstate; park it in a temp project and parse it through the
+		// regular state file pipeline.
+		tmpDir, err := os.MkdirTemp("", "tofu-tfcloud-state-*")
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			finalError = errors.Join(finalError, os.RemoveAll(tmpDir))
+		}()
+		statePath := filepath.Join(tmpDir, "terraform.tfstate")
+		if err := os.WriteFile(statePath, stateBytes, 0o600); err != nil {
+			return nil, err
+		}
+		return LoadTerraformState(ctx, LoadTerraformStateOptions{
+			StateFilePath: statePath,
+			InitUpgrade:   opts.InitUpgrade,
+		})
+	}
+
 	if opts.StateFilePath != "" {
 		// Direct reading JSON case to facilitate testing.
-		if filepath.Ext(opts.StateFilePath) == ".json" {
-			bytes, err := os.ReadFile(opts.StateFilePath)
+		if filepath.Ext(strings.TrimSuffix(opts.StateFilePath, ".gz")) == ".json" {
+			stateBytes, err := readStateFile(opts.StateFilePath)
 			if err != nil {
 				return nil, err
 			}
 			var st tfjson.State
-			if err := json.Unmarshal(bytes, &st); err != nil {
+			if err := json.Unmarshal(stateBytes, &st); err != nil {
 				return nil, err
 			}
+			defaultMissingProviderNames(&st)
 			return &st, nil
 		}
 
@@ -152,7 +198,15 @@ func LoadTerraformState(ctx context.Context, opts LoadTerraformStateOptions) (fi
 	// a cached operation that is cheaper the second time around it reuses the lock file and provider downloads
 	// under .terraform.
 	if err := tofu.Init(ctx); err != nil {
-		return nil, fmt.Errorf("tofu init failed: %w", err)
+		err = classifyTofuError(err)
+		if !opts.InitUpgrade || !errors.Is(err, ErrModuleSourceChanged) {
+			return nil, fmt.Errorf("tofu init failed: %w", err)
+		}
+		// A stale lock file from another tool; re-resolve providers and modules.
+		fmt.Fprintln(os.Stderr, "tofu init failed because the module source changed. Retrying with -upgrade.")
+		if err := tofu.Init(ctx, tfexec.Upgrade(true)); err != nil {
+			return nil, fmt.Errorf("tofu init -upgrade failed: %w", classifyTofuError(err))
+		}
 	}
 
 	// If given an explicit StateFilePath, try ShowStateFile first; fall back to provider rewrite
@@ -162,12 +216,18 @@ func LoadTerraformState(ctx context.Context, opts LoadTerraformStateOptions) (fi
 		if err != nil {
 			return nil, fmt.Errorf("resolving state file path: %w", err)
 		}
+		// tofu only reads uncompressed state; hand it a plain-text copy of gzipped files.
+		absStateFile, cleanup, err := maybeDecompressStateFile(absStateFile)
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
 		state, err := tofu.ShowStateFile(ctx, absStateFile)
 		if err == nil {
 			return state, nil
 		}
-		if strings.Contains(err.Error(), "Failed to load plugin schemas") &&
-			strings.Contains(err.Error(), "while loading schemas for plugin components") {
+		err = classifyTofuError(err)
+		if errors.Is(err, ErrRegistrySchema) {
 			fmt.Fprintln(os.Stderr, "Error reading state file with OpenTofu. Rewriting provider references.")
 			return loadStateFileWithRewrite(ctx, tofu, absStateFile)
 		}
@@ -190,7 +250,7 @@ func tofuNew(projectDir string) (*tfexec.Terraform, error) {
 	// Locate the tofu binary in PATH
 	tofuPath, err := exec.LookPath("tofu")
 	if err != nil {
-		return nil, fmt.Errorf("tofu binary not found in PATH: %w", err)
+		return nil, fmt.Errorf("%w: %w", ErrTofuNotFound, err)
 	}
 
 	// Create a terraform-exec instance with the tofu binary
@@ -255,15 +315,26 @@ func loadWorkspaceState(
 		}()
 	}
 
+	// When a size cap is configured, pull the raw state first so an oversized backend state is
+	// rejected with an actionable error instead of ballooning memory inside `tofu show`.
+	if maxStateSize > 0 {
+		stateData, err := tofu.StatePull(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("tofu state pull failed: %w", err)
+		}
+		if err := checkStateSize(int64(len(stateData)), fmt.Sprintf("pulled from workspace %q", workspace)); err != nil {
+			return nil, err
+		}
+	}
+
 	state, err := tofu.Show(ctx)
+	err = classifyTofuError(err)
 	switch {
 	case err == nil:
 		return state, nil
 
 	// Working around this error: https://github.com/pulumi/pulumi-service/issues/34864
-	case strings.Contains(err.Error(), "Failed to load plugin schemas") &&
-		strings.Contains(err.Error(), "while loading schemas for plugin components"):
-
+	case errors.Is(err, ErrRegistrySchema):
 		fmt.Fprintln(os.Stderr, "Error reading Terraform-generated state with OpenTofu. Rewriting provider references.")
 		return loadStateWithRewrite(ctx, tofu)
 
@@ -311,7 +382,7 @@ func loadStateWithRewrite(ctx context.Context, tofu *tfexec.Terraform) (*tfjson.
 // to registry.opentofu.org, and parses via `tofu show -json`. This is used when loading state from an
 // explicit StateFilePath.
 func loadStateFileWithRewrite(ctx context.Context, tofu *tfexec.Terraform, stateFilePath string) (*tfjson.State, error) {
-	stateData, err := os.ReadFile(stateFilePath)
+	stateData, err := readStateFile(stateFilePath)
 	if err != nil {
 		return nil, fmt.Errorf("reading state file failed: %w", err)
 	}
@@ -319,6 +390,72 @@ func loadStateFileWithRewrite(ctx context.Context, tofu *tfexec.Terraform, state
 	return parseStateWithProviderRewrite(ctx, tofu, stateData)
 }
 
+// gzipMagic is the two-byte signature that opens every gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// readStateFile reads a state file, transparently decompressing gzip-compressed content such as
+// compressed state backups (terraform.tfstate.gz).
+func readStateFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(data, gzipMagic) {
+		if err := checkStateSize(int64(len(data)), path); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing gzipped state file %s: %w", path, err)
+	}
+	defer reader.Close()
+	decompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing gzipped state file %s: %w", path, err)
+	}
+	if err := checkStateSize(int64(len(decompressed)), path); err != nil {
+		return nil, err
+	}
+	return decompressed, nil
+}
+
+// maybeDecompressStateFile writes a plain-text copy of a gzip-compressed state file to a temp file
+// and returns its path along with a cleanup func; uncompressed files are returned unchanged with a
+// no-op cleanup.
+func maybeDecompressStateFile(path string) (string, func(), error) {
+	noop := func() {}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", noop, fmt.Errorf("reading state file failed: %w", err)
+	}
+	if !bytes.HasPrefix(data, gzipMagic) {
+		if err := checkStateSize(int64(len(data)), path); err != nil {
+			return "", noop, err
+		}
+		return path, noop, nil
+	}
+	decompressed, err := readStateFile(path)
+	if err != nil {
+		return "", noop, err
+	}
+	tempFile, err := os.CreateTemp("", "temp-tofu-decompressed-state*.tfstate")
+	if err != nil {
+		return "", noop, err
+	}
+	cleanup := func() { os.Remove(tempFile.Name()) }
+	if _, err := tempFile.Write(decompressed); err != nil {
+		cleanup()
+		return "", noop, err
+	}
+	if err := tempFile.Close(); err != nil {
+		cleanup()
+		return "", noop, err
+	}
+	return tempFile.Name(), cleanup, nil
+}
+
 // parseStateWithProviderRewrite rewrites registry.terraform.io → registry.opentofu.org in state JSON,
 // writes to a temp file, and uses `tofu show -json` to parse it.
 func parseStateWithProviderRewrite(ctx context.Context, tofu *tfexec.Terraform, stateData []byte) (*tfjson.State, error) {
@@ -342,5 +479,6 @@ func parseStateWithProviderRewrite(ctx context.Context, tofu *tfexec.Terraform,
 		return nil, fmt.Errorf("tofu show on rewritten state file failed: %w", err)
 	}
 
+	defaultMissingProviderNames(state)
 	return state, nil
 }
@@ -15,6 +15,8 @@
 package tofu
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"io/fs"
 	"os"
@@ -103,6 +105,54 @@ func Test_LoadTerraformState(t *testing.T) {
 	}
 }
 
+func Test_LoadTerraformState_GzippedShowJSON(t *testing.T) {
+	t.Parallel()
+
+	// A minimal show-format state, stored gzip-compressed as some state backups are.
+	showJSON := `{"format_version":"1.0","values":{"root_module":{"resources":[` +
+		`{"address":"random_pet.example","type":"random_pet","name":"example",` +
+		`"provider_name":"registry.opentofu.org/hashicorp/random","values":{"id":"example-pet"}}]}}}`
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte(showJSON))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	stateFile := filepath.Join(t.TempDir(), "state.json.gz")
+	require.NoError(t, os.WriteFile(stateFile, buf.Bytes(), 0o600))
+
+	state, err := LoadTerraformState(context.Background(), LoadTerraformStateOptions{
+		StateFilePath: stateFile,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(state.Values.RootModule.Resources))
+	require.Equal(t, "random_pet.example", state.Values.RootModule.Resources[0].Address)
+}
+
+func Test_readStateFile(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(`{"version": 4}`)
+
+	plain := filepath.Join(t.TempDir(), "terraform.tfstate")
+	require.NoError(t, os.WriteFile(plain, content, 0o600))
+	got, err := readStateFile(plain)
+	require.NoError(t, err)
+	require.Equal(t, content, got)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	compressed := filepath.Join(t.TempDir(), "terraform.tfstate.gz")
+	require.NoError(t, os.WriteFile(compressed, buf.Bytes(), 0o600))
+	got, err = readStateFile(compressed)
+	require.NoError(t, err)
+	require.Equal(t, content, got)
+}
+
 // copyTestdata copies a testdata directory to a temp dir to avoid .terraform side effects in the source tree.
 func copyTestdata(t *testing.T, srcDir string) string {
 	t.Helper()
@@ -0,0 +1,39 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import "fmt"
+
+// maxStateSize is the process-wide limit controlled by the --max-state-size flag. Zero disables
+// the check.
+var maxStateSize int64
+
+// SetMaxStateSize caps the size in bytes of Terraform states the tool is willing to load.
+// Extremely large states can exhaust memory mid-translation with an opaque allocation failure;
+// the cap turns that into an early, actionable error. Zero (the default) disables the check.
+func SetMaxStateSize(n int64) {
+	maxStateSize = n
+}
+
+// checkStateSize rejects states larger than the configured limit, naming the source so the error
+// points at the offending state.
+func checkStateSize(size int64, source string) error {
+	if maxStateSize > 0 && size > maxStateSize {
+		return fmt.Errorf("state %s is %d bytes, which exceeds --max-state-size=%d; "+
+			"translate per module or use an exclude file to reduce the state size",
+			source, size, maxStateSize)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Not parallel: mutates the package-level max state size setting.
+func Test_maxStateSize(t *testing.T) {
+	prev := maxStateSize
+	defer SetMaxStateSize(prev)
+
+	content := []byte(`{"format_version":"1.0","values":{"root_module":{}}}`)
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	require.NoError(t, os.WriteFile(stateFile, content, 0o600))
+
+	t.Run("rejects states over the limit with an actionable error", func(t *testing.T) {
+		SetMaxStateSize(10)
+		_, err := LoadTerraformState(context.Background(), LoadTerraformStateOptions{
+			StateFilePath: stateFile,
+		})
+		require.ErrorContains(t, err, "exceeds --max-state-size=10")
+		require.ErrorContains(t, err, "translate per module")
+	})
+
+	t.Run("allows states within the limit", func(t *testing.T) {
+		SetMaxStateSize(int64(len(content)))
+		state, err := LoadTerraformState(context.Background(), LoadTerraformStateOptions{
+			StateFilePath: stateFile,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, state.Values)
+	})
+
+	t.Run("zero disables the check", func(t *testing.T) {
+		SetMaxStateSize(0)
+		_, err := LoadTerraformState(context.Background(), LoadTerraformStateOptions{
+			StateFilePath: stateFile,
+		})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,51 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// defaultMissingProviderNames fills in a provider for resources that lack one. Partial states
+// produced by `terraform state rm` (or trimmed by hand) can carry resources without provider
+// configuration; rather than failing the translation, the provider is inferred from the resource
+// type prefix under the default hashicorp namespace ("aws_instance" becomes
+// registry.opentofu.org/hashicorp/aws), with a warning per inferred provider. The guess is wrong
+// for providers published outside the hashicorp namespace; the warning tells the user what was
+// assumed so they can correct the state if needed.
+func defaultMissingProviderNames(state *tfjson.State) {
+	inferred := map[string]int{}
+	_ = VisitResources(state, func(res *tfjson.StateResource) error {
+		if res.ProviderName != "" || res.Type == "" {
+			return nil
+		}
+		name, _, _ := strings.Cut(res.Type, "_")
+		res.ProviderName = "registry.opentofu.org/hashicorp/" + name
+		inferred[res.ProviderName]++
+		return nil
+	}, &VisitOptions{IncludeDataSources: true, IncludeDeposed: true})
+
+	for _, provider := range slices.Sorted(maps.Keys(inferred)) {
+		fmt.Fprintf(os.Stderr,
+			"Warning: %d resources carry no provider in the state (partial state from 'terraform state rm'?); "+
+				"assuming %s\n", inferred[provider], provider)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_defaultMissingProviderNames(t *testing.T) {
+	t.Parallel()
+	state := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{
+					{
+						Address: "aws_instance.web",
+						Mode:    tfjson.ManagedResourceMode,
+						Type:    "aws_instance",
+						Name:    "web",
+					},
+					{
+						Address:      "random_pet.name",
+						Mode:         tfjson.ManagedResourceMode,
+						Type:         "random_pet",
+						Name:         "name",
+						ProviderName: "registry.opentofu.org/hashicorp/random",
+					},
+				},
+			},
+		},
+	}
+
+	defaultMissingProviderNames(state)
+
+	assert.Equal(t, "registry.opentofu.org/hashicorp/aws",
+		state.Values.RootModule.Resources[0].ProviderName,
+		"a missing provider is inferred from the resource type prefix")
+	assert.Equal(t, "registry.opentofu.org/hashicorp/random",
+		state.Values.RootModule.Resources[1].ProviderName,
+		"resources that already carry a provider are left alone")
+}
+
+func Test_LoadTerraformState_partial_state(t *testing.T) {
+	t.Parallel()
+	// A minimal partial state, as produced by `terraform state rm` into a fresh state: one
+	// resource, no provider blocks or provider_name fields anywhere.
+	statePath := filepath.Join(t.TempDir(), "partial.json")
+	partial := `{
+		"format_version": "1.0",
+		"values": {
+			"root_module": {
+				"resources": [
+					{
+						"address": "aws_s3_bucket.logs",
+						"mode": "managed",
+						"type": "aws_s3_bucket",
+						"name": "logs",
+						"values": {"id": "logs-bucket"}
+					}
+				]
+			}
+		}
+	}`
+	require.NoError(t, os.WriteFile(statePath, []byte(partial), 0o600))
+
+	state, err := LoadTerraformState(context.Background(), LoadTerraformStateOptions{StateFilePath: statePath})
+	require.NoError(t, err)
+	require.NotNil(t, state.Values)
+	require.Len(t, state.Values.RootModule.Resources, 1)
+	assert.Equal(t, "registry.opentofu.org/hashicorp/aws",
+		state.Values.RootModule.Resources[0].ProviderName)
+}
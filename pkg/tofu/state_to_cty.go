@@ -29,5 +29,42 @@ func StateToCtyValue(resource *tfjson.StateResource, ty cty.Type) (cty.Value, er
 		return cty.Value{}, err
 	}
 
+	data, err = fillMissingAttributes(data, ty)
+	if err != nil {
+		return cty.Value{}, err
+	}
+
 	return ctyjson.Unmarshal(data, ty)
 }
+
+// fillMissingAttributes adds explicit nulls for top-level attributes the object type declares but
+// the attribute JSON omits. cty's JSON unmarshaling treats every object attribute as required, and
+// states produced through some code paths (notably the tofu refresh workaround) omit attributes
+// such as the "timeouts" block that bridge.ImpliedType synthesizes, which would otherwise fail
+// with an 'attribute "timeouts" is required' error.
+func fillMissingAttributes(data []byte, ty cty.Type) ([]byte, error) {
+	if !ty.IsObjectType() {
+		return data, nil
+	}
+
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return nil, err
+	}
+	if attrs == nil {
+		attrs = map[string]json.RawMessage{}
+	}
+
+	filled := false
+	for name := range ty.AttributeTypes() {
+		if _, ok := attrs[name]; !ok {
+			attrs[name] = json.RawMessage("null")
+			filled = true
+		}
+	}
+	if !filled {
+		return data, nil
+	}
+
+	return json.Marshal(attrs)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"testing"
 
+	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/require"
 	"github.com/zclconf/go-cty/cty"
@@ -74,3 +75,32 @@ func TestResourceToCtyValue(t *testing.T) {
 
 	autogold.ExpectFile(t, value)
 }
+
+func TestStateToCtyValue_fills_missing_timeouts(t *testing.T) {
+	t.Parallel()
+
+	res := &tfjson.StateResource{
+		Address: "aws_instance.web",
+		Type:    "aws_instance",
+		AttributeValues: map[string]interface{}{
+			"id":   "i-0123456789abcdef0",
+			"name": "web",
+		},
+	}
+
+	// The implied type of a schema with timeout support declares a "timeouts" attribute that
+	// refresh-produced states omit; it must unmarshal as null rather than fail as required.
+	resourceType := cty.Object(map[string]cty.Type{
+		"id":   cty.String,
+		"name": cty.String,
+		"timeouts": cty.Object(map[string]cty.Type{
+			"create": cty.String,
+			"delete": cty.String,
+		}),
+	})
+
+	value, err := StateToCtyValue(res, resourceType)
+	require.NoError(t, err)
+	require.True(t, value.GetAttr("timeouts").IsNull())
+	require.Equal(t, "web", value.GetAttr("name").AsString())
+}
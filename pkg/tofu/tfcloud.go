@@ -0,0 +1,135 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// TFCloudOptions identifies a Terraform Cloud / HCP Terraform workspace to pull the latest state
+// version from.
+type TFCloudOptions struct {
+	// Organization name, such as "my-org".
+	Org string
+
+	// Workspace name within the organization, such as "prod".
+	Workspace string
+
+	// API token. When empty, the TFE_TOKEN environment variable is used.
+	Token string
+
+	// BaseURL overrides the API endpoint for Terraform Enterprise installs.
+	// Defaults to https://app.terraform.io.
+	BaseURL string
+}
+
+// defaultTFCloudBaseURL is the HCP Terraform API endpoint.
+const defaultTFCloudBaseURL = "https://app.terraform.io"
+
+// fetchTFCloudState downloads the raw state of a workspace's current state version through the
+// Terraform Cloud API: the workspace is resolved to its ID, the current state version queried for
+// its hosted download URL, and the state fetched from there. The returned bytes are in the raw
+// .tfstate format and still need parsing through tofu.
+func fetchTFCloudState(ctx context.Context, opts *TFCloudOptions) ([]byte, error) {
+	token := opts.Token
+	if token == "" {
+		token = os.Getenv("TFE_TOKEN")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("no Terraform Cloud API token: set the TFE_TOKEN environment variable " +
+			"or pass a token explicitly")
+	}
+	base := opts.BaseURL
+	if base == "" {
+		base = defaultTFCloudBaseURL
+	}
+
+	workspaceURL := fmt.Sprintf("%s/api/v2/organizations/%s/workspaces/%s",
+		base, url.PathEscape(opts.Org), url.PathEscape(opts.Workspace))
+	var workspace struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := tfCloudGetJSON(ctx, token, workspaceURL, &workspace); err != nil {
+		return nil, fmt.Errorf("resolving workspace %s/%s: %w", opts.Org, opts.Workspace, err)
+	}
+
+	stateVersionURL := fmt.Sprintf("%s/api/v2/workspaces/%s/current-state-version", base, workspace.Data.ID)
+	var stateVersion struct {
+		Data struct {
+			Attributes struct {
+				HostedStateDownloadURL string `json:"hosted-state-download-url"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := tfCloudGetJSON(ctx, token, stateVersionURL, &stateVersion); err != nil {
+		return nil, fmt.Errorf("resolving current state version of workspace %s/%s: %w",
+			opts.Org, opts.Workspace, err)
+	}
+	if stateVersion.Data.Attributes.HostedStateDownloadURL == "" {
+		return nil, fmt.Errorf("workspace %s/%s has no state versions yet", opts.Org, opts.Workspace)
+	}
+
+	state, err := tfCloudGet(ctx, token, stateVersion.Data.Attributes.HostedStateDownloadURL)
+	if err != nil {
+		return nil, fmt.Errorf("downloading state of workspace %s/%s: %w", opts.Org, opts.Workspace, err)
+	}
+	return state, nil
+}
+
+// tfCloudGet performs an authenticated GET against the Terraform Cloud API, classifying the
+// status codes users most commonly hit into actionable errors.
+func tfCloudGet(ctx context.Context, token, rawURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, fmt.Errorf("Terraform Cloud rejected the API token (HTTP %d): check TFE_TOKEN "+
+			"and that the token has access to the organization", resp.StatusCode)
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("not found (HTTP 404): check the organization and workspace names; " +
+			"Terraform Cloud also returns 404 when the token cannot see the resource")
+	default:
+		return nil, fmt.Errorf("unexpected HTTP %d from Terraform Cloud", resp.StatusCode)
+	}
+}
+
+func tfCloudGetJSON(ctx context.Context, token, rawURL string, out interface{}) error {
+	data, err := tfCloudGet(ctx, token, rawURL)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,102 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tofu
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fetchTFCloudState(t *testing.T) {
+	t.Parallel()
+
+	stateJSON := `{"version": 4, "resources": []}`
+
+	newServer := func(t *testing.T) *httptest.Server {
+		mux := http.NewServeMux()
+		var server *httptest.Server
+		mux.HandleFunc("/api/v2/organizations/my-org/workspaces/prod", func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer good-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"data": {"id": "ws-123"}}`)
+		})
+		mux.HandleFunc("/api/v2/workspaces/ws-123/current-state-version", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"data": {"attributes": {"hosted-state-download-url": %q}}}`,
+				server.URL+"/state-download")
+		})
+		mux.HandleFunc("/state-download", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, stateJSON)
+		})
+		server = httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	t.Run("downloads the current state version", func(t *testing.T) {
+		t.Parallel()
+		server := newServer(t)
+		state, err := fetchTFCloudState(context.Background(), &TFCloudOptions{
+			Org:       "my-org",
+			Workspace: "prod",
+			Token:     "good-token",
+			BaseURL:   server.URL,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, stateJSON, string(state))
+	})
+
+	t.Run("rejected token gives an actionable error", func(t *testing.T) {
+		t.Parallel()
+		server := newServer(t)
+		_, err := fetchTFCloudState(context.Background(), &TFCloudOptions{
+			Org:       "my-org",
+			Workspace: "prod",
+			Token:     "bad-token",
+			BaseURL:   server.URL,
+		})
+		require.ErrorContains(t, err, "rejected the API token")
+	})
+
+	t.Run("unknown workspace reports not found", func(t *testing.T) {
+		t.Parallel()
+		server := newServer(t)
+		_, err := fetchTFCloudState(context.Background(), &TFCloudOptions{
+			Org:       "my-org",
+			Workspace: "missing",
+			Token:     "good-token",
+			BaseURL:   server.URL,
+		})
+		require.ErrorContains(t, err, "404")
+	})
+
+}
+
+func Test_fetchTFCloudState_missing_token(t *testing.T) {
+	// Clears TFE_TOKEN via t.Setenv, so no t.Parallel.
+	t.Setenv("TFE_TOKEN", "")
+	_, err := fetchTFCloudState(context.Background(), &TFCloudOptions{
+		Org:       "my-org",
+		Workspace: "prod",
+	})
+	require.ErrorContains(t, err, "TFE_TOKEN")
+}
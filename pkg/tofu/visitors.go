@@ -15,6 +15,9 @@
 package tofu
 
 import (
+	"fmt"
+	"strings"
+
 	tfjson "github.com/hashicorp/terraform-json"
 )
 
@@ -23,6 +26,11 @@ type VisitOptions struct {
 	// IncludeDataSources controls whether data sources should be included during traversal.
 	// Default: false (data sources are skipped by default)
 	IncludeDataSources bool
+
+	// IncludeDeposed controls whether deposed resource instances, left behind by failed
+	// create-before-destroy cycles, should be included during traversal.
+	// Default: false (deposed instances are skipped by default)
+	IncludeDeposed bool
 }
 
 // VisitResources recursively visits all resources in a Terraform state
@@ -52,8 +60,13 @@ func visitModule(module *tfjson.StateModule, visitor func(*tfjson.StateResource)
 			continue
 		}
 
+		// Skip deposed instances unless configured to include them
+		if !opts.IncludeDeposed && res.DeposedKey != "" {
+			continue
+		}
+
 		if err := visitor(res); err != nil {
-			return err
+			return fmt.Errorf("failed visiting %s: %w", resourceAddress(module, res), err)
 		}
 	}
 
@@ -66,3 +79,14 @@ func visitModule(module *tfjson.StateModule, visitor func(*tfjson.StateResource)
 
 	return nil
 }
+
+// resourceAddress names a resource for visitor error messages. Resource addresses in a well-formed
+// state already carry their module path ("module.x.aws_instance.y"); when one does not, the
+// enclosing module's address is prefixed so the error still points at the right module instance.
+func resourceAddress(module *tfjson.StateModule, res *tfjson.StateResource) string {
+	addr := res.Address
+	if module.Address != "" && !strings.HasPrefix(addr, module.Address+".") {
+		addr = module.Address + "." + addr
+	}
+	return addr
+}
@@ -124,6 +124,49 @@ func TestVisitResources_IncludeDataSources(t *testing.T) {
 	assert.Equal(t, []string{"aws_instance.web", "data.aws_ami.ubuntu"}, visited)
 }
 
+func TestVisitResources_SkipDeposed(t *testing.T) {
+	t.Parallel()
+	state := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				Resources: []*tfjson.StateResource{
+					{
+						Address: "aws_instance.web",
+						Mode:    tfjson.ManagedResourceMode,
+						Type:    "aws_instance",
+						Name:    "web",
+					},
+					{
+						Address:    "aws_instance.web",
+						Mode:       tfjson.ManagedResourceMode,
+						Type:       "aws_instance",
+						Name:       "web",
+						DeposedKey: "00000001",
+					},
+				},
+			},
+		},
+	}
+
+	// Test with default options (should skip deposed instances)
+	visited := []string{}
+	err := VisitResources(state, func(res *tfjson.StateResource) error {
+		visited = append(visited, res.Address)
+		return nil
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"aws_instance.web"}, visited)
+
+	// Test with IncludeDeposed = true
+	deposedKeys := []string{}
+	err = VisitResources(state, func(res *tfjson.StateResource) error {
+		deposedKeys = append(deposedKeys, res.DeposedKey)
+		return nil
+	}, &VisitOptions{IncludeDeposed: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "00000001"}, deposedKeys)
+}
+
 func TestVisitResources_WithChildModules(t *testing.T) {
 	t.Parallel()
 	state := &tfjson.State{
@@ -221,5 +264,38 @@ func TestVisitResources_VisitorError(t *testing.T) {
 	}, nil)
 
 	require.Error(t, err)
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.ErrorContains(t, err, "failed visiting aws_instance.web1")
 	assert.Equal(t, []string{"aws_instance.web1"}, visited)
 }
+
+func TestVisitResources_VisitorErrorNamesModule(t *testing.T) {
+	t.Parallel()
+	state := &tfjson.State{
+		Values: &tfjson.StateValues{
+			RootModule: &tfjson.StateModule{
+				ChildModules: []*tfjson.StateModule{
+					{
+						Address: "module.network",
+						Resources: []*tfjson.StateResource{
+							{
+								// A state writer that omits the module prefix from resource
+								// addresses; the error should restore it from the module.
+								Address: "aws_vpc.main",
+								Mode:    tfjson.ManagedResourceMode,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err := VisitResources(state, func(res *tfjson.StateResource) error {
+		return assert.AnError
+	}, nil)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.ErrorContains(t, err, "failed visiting module.network.aws_vpc.main")
+}
@@ -0,0 +1,79 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// resourceTypeCount counts, for one resource type, how many resources translated successfully and
+// how many were skipped. Translated resources carry Pulumi type tokens; skipped resources never
+// got a Pulumi type and are counted under their Terraform type.
+type resourceTypeCount struct {
+	Type       string
+	Translated int
+	Skipped    int
+}
+
+// resourceTypeStats aggregates a translation result into per-type counts, sorted by total count
+// descending with ties broken by type name. It gives users a quick sense of coverage and which
+// resource types are worth fixing first.
+func resourceTypeStats(res *TranslateStateResult) []resourceTypeCount {
+	counts := map[string]*resourceTypeCount{}
+	get := func(typ string) *resourceTypeCount {
+		if counts[typ] == nil {
+			counts[typ] = &resourceTypeCount{Type: typ}
+		}
+		return counts[typ]
+	}
+	for _, r := range res.Export.Deployment.Resources {
+		get(string(r.Type)).Translated++
+	}
+	for _, skipped := range res.ErrorMessages {
+		get(skipped.ResourceType).Skipped++
+	}
+
+	stats := make([]resourceTypeCount, 0, len(counts))
+	for _, count := range counts {
+		stats = append(stats, *count)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		ti, tj := stats[i].Translated+stats[i].Skipped, stats[j].Translated+stats[j].Skipped
+		if ti != tj {
+			return ti > tj
+		}
+		return stats[i].Type < stats[j].Type
+	})
+	return stats
+}
+
+// formatResourceTypeStats renders per-type counts as an indented breakdown, one type per line.
+func formatResourceTypeStats(stats []resourceTypeCount) string {
+	if len(stats) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString("Translated resources by type:\n")
+	for _, stat := range stats {
+		line := fmt.Sprintf("  %d/%d %s translated", stat.Translated, stat.Translated+stat.Skipped, stat.Type)
+		if stat.Skipped > 0 {
+			line += fmt.Sprintf(", %d skipped", stat.Skipped)
+		}
+		sb.WriteString(line + "\n")
+	}
+	return sb.String()
+}
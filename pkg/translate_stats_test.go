@@ -0,0 +1,59 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_resourceTypeStats(t *testing.T) {
+	t.Parallel()
+
+	res := &TranslateStateResult{
+		Export: StackExport{
+			Deployment: apitype.DeploymentV3{
+				Resources: []apitype.ResourceV3{
+					{Type: tokens.Type("aws:s3/bucket:Bucket")},
+					{Type: tokens.Type("aws:s3/bucket:Bucket")},
+					{Type: tokens.Type("aws:ec2/instance:Instance")},
+				},
+			},
+		},
+		ErrorMessages: []ErroredResource{
+			{ResourceType: "aws_s3_bucket_object"},
+			{ResourceType: "aws:s3/bucket:Bucket"},
+		},
+	}
+
+	stats := resourceTypeStats(res)
+	assert.Equal(t, []resourceTypeCount{
+		{Type: "aws:s3/bucket:Bucket", Translated: 2, Skipped: 1},
+		{Type: "aws:ec2/instance:Instance", Translated: 1},
+		{Type: "aws_s3_bucket_object", Skipped: 1},
+	}, stats)
+
+	assert.Equal(t,
+		"Translated resources by type:\n"+
+			"  2/3 aws:s3/bucket:Bucket translated, 1 skipped\n"+
+			"  1/1 aws:ec2/instance:Instance translated\n"+
+			"  0/1 aws_s3_bucket_object translated, 1 skipped\n",
+		formatResourceTypeStats(stats))
+
+	assert.Empty(t, formatResourceTypeStats(nil))
+}
@@ -0,0 +1,74 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/providermap"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+)
+
+// TypeMappingEntry records which Pulumi type and name one Terraform resource is expected to map
+// to, without any state conversion.
+type TypeMappingEntry struct {
+	// Terraform resource address such as "aws_instance.app_server".
+	TFAddr string `json:"tf-addr"`
+
+	// Terraform resource type such as "aws_instance".
+	TFType string `json:"tf-type"`
+
+	// Expected Pulumi type token, derived from the bridge's default naming. Overridden tokens in
+	// the actual provider may differ; empty when no token could be derived.
+	PulumiType string `json:"pulumi-type,omitempty"`
+
+	// Name of the resolved Pulumi provider, or "terraform-provider" for dynamically bridged ones.
+	PulumiProvider string `json:"pulumi-provider"`
+
+	// Pulumi resource name derived from the Terraform address.
+	PulumiName string `json:"pulumi-name"`
+}
+
+// TypeMappings computes the expected tf-addr to Pulumi type mapping for every managed resource in
+// a Terraform state. Unlike a full translation it skips state conversion and import-ID inference
+// entirely, so it needs no provider schemas and runs without installing any providers. The result
+// follows the state's resource order.
+func TypeMappings(tfState *tfjson.State) ([]TypeMappingEntry, error) {
+	var entries []TypeMappingEntry
+	err := tofu.VisitResources(tfState, func(res *tfjson.StateResource) error {
+		entry := TypeMappingEntry{
+			TFAddr:     res.Address,
+			TFType:     res.Type,
+			PulumiName: PulumiNameFromTerraformAddress(res.Address, res.Type),
+		}
+
+		recommended := providermap.RecommendPulumiProvider(providermap.TerraformProvider{
+			Identifier: providermap.TerraformProviderName(res.ProviderName),
+		})
+		if recommended.UseDynamicBridging {
+			entry.PulumiProvider = "terraform-provider"
+		} else {
+			entry.PulumiProvider = recommended.StaticallyBridgedProvider.Identifier
+			entry.PulumiType = defaultPulumiTypeToken(res.Type, res.ProviderName, entry.PulumiProvider)
+		}
+
+		entries = append(entries, entry)
+		return nil
+	}, nil) // Use default options (skips data sources)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/tofu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TypeMappings(t *testing.T) {
+	t.Parallel()
+
+	tfState, err := tofu.LoadTerraformState(context.Background(), tofu.LoadTerraformStateOptions{
+		StateFilePath: "testdata/bucket_state.json",
+	})
+	require.NoError(t, err)
+
+	entries, err := TypeMappings(tfState)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+
+	entry := entries[0]
+	assert.Equal(t, "aws_s3_bucket.example", entry.TFAddr)
+	assert.Equal(t, "aws_s3_bucket", entry.TFType)
+	assert.Equal(t, "aws", entry.PulumiProvider)
+	assert.Equal(t, "example", entry.PulumiName)
+	// Default bridge naming; the actual provider overrides this to aws:s3/bucket:Bucket.
+	assert.Equal(t, "aws:s3Bucket:S3Bucket", entry.PulumiType)
+}
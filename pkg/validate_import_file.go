@@ -0,0 +1,139 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+)
+
+// LoadImportFile reads an ImportFile from a JSON file as written for `pulumi import --file`.
+func LoadImportFile(path string) (ImportFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ImportFile{}, fmt.Errorf("failed to read import file: %w", err)
+	}
+	var file ImportFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return ImportFile{}, fmt.Errorf("failed to parse import file %s: %w", path, err)
+	}
+	return file, nil
+}
+
+// ValidateImportFile checks an import file for problems that would make `pulumi import --file`
+// fail: resources without a resolved ID, malformed or unknown type tokens, duplicate names, and
+// provider or parent references that do not resolve through the name table. When a deployment is
+// given, name-table URNs and type packages are additionally checked against it. All problems are
+// reported at once rather than stopping at the first.
+func ValidateImportFile(file ImportFile, deployment *apitype.DeploymentV3) []string {
+	var problems []string
+
+	// Packages served by providers visible to the import: explicit providers in the name table
+	// plus default providers already present in the stack.
+	knownPackages := map[string]bool{}
+	for _, urn := range file.NameTable {
+		if pkg, ok := providerPackageFromURNType(urnType(string(urn))); ok {
+			knownPackages[pkg] = true
+		}
+	}
+	deployedURNs := map[string]bool{}
+	if deployment != nil {
+		for _, res := range deployment.Resources {
+			deployedURNs[string(res.URN)] = true
+			if pkg, ok := providerPackageFromURNType(string(res.Type)); ok {
+				knownPackages[pkg] = true
+			}
+		}
+		for name, urn := range file.NameTable {
+			if !deployedURNs[string(urn)] {
+				problems = append(problems,
+					fmt.Sprintf("name table entry %q refers to %s, which is not in the stack", name, urn))
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, res := range file.Resources {
+		where := fmt.Sprintf("resource %s (%s)", res.Name, res.Type)
+
+		if res.ID == "" {
+			problems = append(problems, where+": import ID is not resolved")
+		}
+		if res.Name == "" {
+			problems = append(problems, fmt.Sprintf("resource of type %s has no name", res.Type))
+		}
+
+		pkg, ok := packageFromTypeToken(res.Type)
+		if !ok {
+			problems = append(problems, where+": type is not a valid <package>:<module>:<Resource> token")
+		} else if len(knownPackages) > 0 && !knownPackages[pkg] {
+			problems = append(problems,
+				fmt.Sprintf("%s: no provider for package %q is known to the project", where, pkg))
+		}
+
+		key := res.Type + "::" + res.Name
+		if seen[key] {
+			problems = append(problems, where+": duplicate name for this type")
+		}
+		seen[key] = true
+
+		if res.Provider != "" {
+			if _, ok := file.NameTable[res.Provider]; !ok {
+				problems = append(problems,
+					fmt.Sprintf("%s: provider %q is not in the name table", where, res.Provider))
+			}
+		}
+		if res.Parent != "" {
+			if _, ok := file.NameTable[res.Parent]; !ok {
+				problems = append(problems,
+					fmt.Sprintf("%s: parent %q is not in the name table", where, res.Parent))
+			}
+		}
+	}
+
+	return problems
+}
+
+// packageFromTypeToken extracts the package name from a <package>:<module>:<Resource> type token.
+func packageFromTypeToken(typ string) (string, bool) {
+	parts := strings.Split(typ, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return "", false
+	}
+	return parts[0], true
+}
+
+// providerPackageFromURNType returns the package a pulumi:providers:* type serves.
+func providerPackageFromURNType(typ string) (string, bool) {
+	pkg, ok := strings.CutPrefix(typ, "pulumi:providers:")
+	if !ok || pkg == "" {
+		return "", false
+	}
+	return pkg, true
+}
+
+// urnType extracts the type component of a URN such as
+// urn:pulumi:stack::project::pulumi:providers:aws::default_7_12_0.
+func urnType(urn string) string {
+	parts := strings.Split(urn, "::")
+	if len(parts) != 4 {
+		return ""
+	}
+	return parts[2]
+}
@@ -0,0 +1,116 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/apitype"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateImportFile(t *testing.T) {
+	t.Parallel()
+
+	providerURN := resource.URN("urn:pulumi:dev::proj::pulumi:providers:aws::default_7_12_0")
+
+	t.Run("valid file has no problems", func(t *testing.T) {
+		t.Parallel()
+
+		file := ImportFile{
+			NameTable: map[string]resource.URN{"default_7_12_0": providerURN},
+			Resources: []ImportResource{{
+				Type:     "aws:s3/bucket:Bucket",
+				Name:     "my-bucket",
+				ID:       "my-bucket-id",
+				Provider: "default_7_12_0",
+			}},
+		}
+		require.Empty(t, ValidateImportFile(file, nil))
+	})
+
+	t.Run("all problems are reported at once", func(t *testing.T) {
+		t.Parallel()
+
+		file := ImportFile{
+			NameTable: map[string]resource.URN{"default_7_12_0": providerURN},
+			Resources: []ImportResource{
+				{Type: "aws:s3/bucket:Bucket", Name: "a", ID: ""},
+				{Type: "not-a-token", Name: "b", ID: "id-b"},
+				{Type: "aws:s3/bucket:Bucket", Name: "c", ID: "id-c", Provider: "missing"},
+				{Type: "aws:s3/bucket:Bucket", Name: "c", ID: "id-c2", Parent: "gone"},
+			},
+		}
+
+		problems := ValidateImportFile(file, nil)
+		joined := strings.Join(problems, "\n")
+		require.Len(t, problems, 5)
+		require.Contains(t, joined, "import ID is not resolved")
+		require.Contains(t, joined, "not a valid")
+		require.Contains(t, joined, `provider "missing" is not in the name table`)
+		require.Contains(t, joined, "duplicate name for this type")
+	})
+
+	t.Run("unknown package is flagged", func(t *testing.T) {
+		t.Parallel()
+
+		file := ImportFile{
+			NameTable: map[string]resource.URN{"default_7_12_0": providerURN},
+			Resources: []ImportResource{{
+				Type: "gcp:storage/bucket:Bucket",
+				Name: "b",
+				ID:   "id",
+			}},
+		}
+
+		problems := ValidateImportFile(file, nil)
+		require.Len(t, problems, 1)
+		require.Contains(t, problems[0], `no provider for package "gcp"`)
+	})
+
+	t.Run("name table is checked against the deployment", func(t *testing.T) {
+		t.Parallel()
+
+		file := ImportFile{
+			NameTable: map[string]resource.URN{"default_7_12_0": providerURN},
+			Resources: []ImportResource{{
+				Type:     "aws:s3/bucket:Bucket",
+				Name:     "my-bucket",
+				ID:       "my-bucket-id",
+				Provider: "default_7_12_0",
+			}},
+		}
+
+		deployment := &apitype.DeploymentV3{
+			Resources: []apitype.ResourceV3{{
+				URN:  providerURN,
+				Type: "pulumi:providers:aws",
+			}},
+		}
+		require.Empty(t, ValidateImportFile(file, deployment))
+
+		stale := &apitype.DeploymentV3{
+			Resources: []apitype.ResourceV3{{
+				URN:  resource.URN("urn:pulumi:dev::proj::pulumi:providers:aws::other"),
+				Type: "pulumi:providers:aws",
+			}},
+		}
+		problems := ValidateImportFile(file, stale)
+		require.Len(t, problems, 1)
+		require.Contains(t, problems[0], "not in the stack")
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright 2016-2025, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/pulumi/pulumi-tool-terraform-migrate/pkg/providermap"
+)
+
+// WarmupResult reports the outcome of prefetching one provider.
+type WarmupResult struct {
+	// Full Terraform provider name such as "registry.terraform.io/hashicorp/aws".
+	TerraformProvider string
+
+	// Resolved Pulumi provider name and version; empty when warmup failed before resolution.
+	PulumiProvider string
+	Version        string
+
+	// How long the download and mapping retrieval took.
+	Duration time.Duration
+
+	// Err is non-nil when this provider could not be prefetched.
+	Err error
+}
+
+// WarmupProviders downloads the provider binaries and mappings needed to translate a Terraform
+// state, populating the same caches the translation itself uses. Running it up front separates the
+// slow network phase from the translation phase, which can then run without mid-run stalls (or
+// fully offline). Providers are warmed one at a time so each result carries its own timing; a
+// failure for one provider does not stop the others.
+func WarmupProviders(
+	ctx context.Context,
+	tfState *tfjson.State,
+	providerVersions map[string]string,
+) ([]WarmupResult, error) {
+	tfProviders, err := getTerraformProvidersForTerraformState(tfState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get terraform providers: %w", err)
+	}
+	sort.Slice(tfProviders, func(i, j int) bool { return tfProviders[i] < tfProviders[j] })
+
+	var results []WarmupResult
+	for _, tfProvider := range tfProviders {
+		start := time.Now()
+		result := WarmupResult{TerraformProvider: string(tfProvider)}
+
+		providers, err := PulumiProvidersForTerraformProviders(
+			[]providermap.TerraformProviderName{tfProvider}, providerVersions)
+		result.Duration = time.Since(start)
+		if err != nil {
+			result.Err = err
+		} else if provider, ok := providers[tfProvider]; ok {
+			result.PulumiProvider = requiredProviderName(provider)
+			result.Version = provider.Version
+		}
+
+		results = append(results, result)
+	}
+	return results, nil
+}